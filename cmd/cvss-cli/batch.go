@@ -0,0 +1,582 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/scagogogo/cvss-parser/pkg/csvio"
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+)
+
+const batchSummary = "parse and score every record of the files or stdin"
+
+// 批量模式的输入格式
+const (
+	inputAuto   = "auto"
+	inputLines  = "lines"
+	inputCSV    = "csv"
+	inputNDJSON = "ndjson"
+)
+
+// 批量模式的输出格式
+const (
+	formatTable = "table"
+	formatCSV   = "csv"
+	formatJSONL = "jsonl"
+)
+
+var batchFormats = []string{formatTable, formatCSV, formatJSONL}
+
+// batchRecord 从输入中读取的一条记录
+type batchRecord struct {
+
+	// 文件名，标准输入为 stdin
+	source string
+
+	// 记录所在的行号，从1开始
+	line int
+
+	// 记录中的向量，NDJSON 中取不出向量时是原始的一行
+	input string
+
+	// 读取时就失败了，比如 NDJSON 中的一行不是合法的JSON
+	err error
+}
+
+func (x *batchRecord) position() string {
+	return fmt.Sprintf("%s:%d", x.source, x.line)
+}
+
+// batchResult 一条记录的处理结果，err 不为nil时其它字段都是空的
+type batchResult struct {
+	record   *batchRecord
+	vector   string
+	scores   *cvss.Scores
+	score    float64
+	severity cvss.Severity
+
+	// 是否设置了时间指标和环境指标，没有设置时不输出对应的评分
+	temporal      bool
+	environmental bool
+
+	err error
+}
+
+// batchJob 把记录交给 worker 处理，处理结果通过 result 按照读取的顺序交给输出
+type batchJob struct {
+	record *batchRecord
+	result chan *batchResult
+}
+
+// batchSource 一个打开了的输入
+type batchSource struct {
+	name   string
+	format string
+	r      io.Reader
+	closer io.Closer
+}
+
+// runBatch 并发地解析和评分每一条记录，按照输入的顺序输出结果，失败的记录汇总输出到 stderr
+func runBatch(o *options, args []string) error {
+	flags := o.newFlagSet("batch", batchFormats...)
+	input := flags.String("input", inputAuto, "input format, auto, lines, csv or ndjson; auto detects it from the file extension and uses lines for stdin")
+	field := flags.String("field", csvio.ColumnVector, "CSV column or NDJSON field holding the vector")
+	workers := flags.Int("workers", runtime.NumCPU(), "number of records processed concurrently")
+	flags.Usage = func() {
+		fmt.Fprintf(o.stderr, "usage: cvss-cli batch [flags] [file...]\n\n%s, - or no file reads stdin\n\nflags:\n", batchSummary)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+	if err := o.init(batchFormats...); err != nil {
+		return err
+	}
+	if !containsString([]string{inputAuto, inputLines, inputCSV, inputNDJSON}, *input) {
+		fmt.Fprintf(o.stderr, "invalid -input %q, must be one of auto, lines, csv, ndjson\n", *input)
+		return errUsage
+	}
+	if *workers < 1 {
+		fmt.Fprintf(o.stderr, "invalid -workers %d, must be at least 1\n", *workers)
+		return errUsage
+	}
+
+	sources, err := openBatchSources(o, flags.Args(), *input)
+	defer func() {
+		for _, source := range sources {
+			if source.closer != nil {
+				source.closer.Close()
+			}
+		}
+	}()
+	if err != nil {
+		fmt.Fprintf(o.stderr, "cvss-cli: %s\n", err)
+		return errInvalidReported
+	}
+
+	w := newBatchWriter(o.format, o.stdout)
+	failures, readErr, err := processBatch(o, sources, *field, *workers, w)
+	if err != nil {
+		return err
+	}
+	if err := w.flush(); err != nil {
+		return err
+	}
+
+	for _, failure := range failures {
+		fmt.Fprintf(o.stderr, "%s: %s\n", failure.record.position(), failureMessage(failure))
+	}
+	if len(failures) != 0 {
+		fmt.Fprintf(o.stderr, "cvss-cli: %d of %d records failed\n", len(failures), w.count())
+	}
+	var e *inputError
+	switch {
+	case readErr == nil:
+	case errors.As(readErr, &e):
+		fmt.Fprintf(o.stderr, "cvss-cli: %s\n", readErr)
+		return errInvalidReported
+	default:
+		return readErr
+	}
+	if len(failures) != 0 {
+		return errInvalidReported
+	}
+	return nil
+}
+
+func openBatchSources(o *options, paths []string, input string) ([]*batchSource, error) {
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+	sources := make([]*batchSource, 0, len(paths))
+	for _, path := range paths {
+		source := &batchSource{name: path, format: input}
+		if path == "-" {
+			source.name = "stdin"
+			source.r = o.stdin
+		} else {
+			f, err := os.Open(path)
+			if err != nil {
+				return sources, &inputError{input: path, err: err}
+			}
+			source.r, source.closer = f, f
+		}
+		if source.format == inputAuto {
+			source.format = detectInputFormat(path)
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+// detectInputFormat 根据文件的扩展名判断输入格式，标准输入和其它扩展名都当作每行一个向量
+func detectInputFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return inputCSV
+	case ".ndjson", ".jsonl":
+		return inputNDJSON
+	default:
+		return inputLines
+	}
+}
+
+// processBatch 一个 goroutine 按顺序读取记录，workers 个 goroutine 并发处理，当前 goroutine 按照读取的顺序输出。
+// 返回失败的记录、读取输入时的错误以及输出时的错误，输出出错时会停止读取
+func processBatch(o *options, sources []*batchSource, field string, workers int, w batchWriter) ([]*batchResult, error, error) {
+	jobs := make(chan *batchJob, workers)
+	queue := make(chan *batchJob, workers*4)
+	done := make(chan struct{})
+	defer close(done)
+
+	var readErr error
+	go func() {
+		defer close(queue)
+		defer close(jobs)
+		emit := func(record *batchRecord) bool {
+			job := &batchJob{record: record, result: make(chan *batchResult, 1)}
+			select {
+			case queue <- job:
+			case <-done:
+				return false
+			}
+			select {
+			case jobs <- job:
+				return true
+			case <-done:
+				return false
+			}
+		}
+		for _, source := range sources {
+			if readErr = readBatchSource(source, field, emit); readErr != nil {
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for job := range jobs {
+				job.result <- processRecord(o, job.record)
+			}
+		}()
+	}
+
+	failures := make([]*batchResult, 0)
+	for job := range queue {
+		result := <-job.result
+		if result.err != nil {
+			failures = append(failures, result)
+		}
+		if err := w.write(result); err != nil {
+			return failures, nil, err
+		}
+	}
+	// queue 关闭之后读取 goroutine 已经退出，可以安全地读取 readErr
+	return failures, readErr, nil
+}
+
+func processRecord(o *options, record *batchRecord) *batchResult {
+	result := &batchResult{record: record}
+	if record.err != nil {
+		result.err = record.err
+		return result
+	}
+	cvss3x, err := parseInput(o, record.input)
+	if err != nil {
+		// 输出中已经有输入的向量了，这里只保留原因
+		result.err = errors.Unwrap(err)
+		return result
+	}
+	calculator := cvss.NewCalculator(cvss3x)
+	if result.scores, err = calculator.CalculateScores(); err == nil {
+		result.score, err = calculator.Calculate()
+	}
+	if err != nil {
+		result.err = err
+		return result
+	}
+	result.vector = cvss3x.String()
+	result.temporal, result.environmental = cvss3x.HasTemporal(), cvss3x.HasEnvironmental()
+	result.severity = cvss.SeverityOf(result.score)
+	return result
+}
+
+func failureMessage(result *batchResult) string {
+	if result.record.input == "" {
+		return result.err.Error()
+	}
+	return fmt.Sprintf("%s: %s", result.record.input, result.err)
+}
+
+// readBatchSource 读取一个输入中的所有记录，emit 返回false时停止读取
+func readBatchSource(source *batchSource, field string, emit func(record *batchRecord) bool) error {
+	switch source.format {
+	case inputCSV:
+		return readBatchCSV(source, field, emit)
+	case inputNDJSON:
+		return readBatchLines(source, func(record *batchRecord) bool {
+			if vector, err := ndjsonVector(record.input, field); err != nil {
+				record.err = err
+			} else {
+				record.input = vector
+			}
+			return emit(record)
+		})
+	default:
+		return readBatchLines(source, emit)
+	}
+}
+
+// readBatchLines 每行一条记录，跳过空行和以 # 开头的注释
+func readBatchLines(source *batchSource, emit func(record *batchRecord) bool) error {
+	scanner := bufio.NewScanner(source.r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if line == 1 {
+			text = strings.TrimPrefix(text, "\ufeff")
+		}
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if !emit(&batchRecord{source: source.name, line: line, input: text}) {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read %s: %w", source.name, err)
+	}
+	return nil
+}
+
+// ndjsonVector 从一行JSON中取出向量，field 字段可以是向量字符串，也可以是 FIRST JSON Schema 的对象，
+// 没有 field 字段时使用顶层的 vectorString ，这样 FIRST JSON 本身也可以直接读取
+func ndjsonVector(line string, field string) (string, error) {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(line), &fields); err != nil {
+		return "", err
+	}
+	value, ok := fields[field]
+	if !ok {
+		if value, ok = fields["vectorString"]; !ok {
+			return "", fmt.Errorf("field %s not found", field)
+		}
+		field = "vectorString"
+	}
+	if string(value) == "null" {
+		return "", fmt.Errorf("field %s is null", field)
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return s, nil
+	}
+	var object struct {
+		VectorString string `json:"vectorString"`
+	}
+	if err := json.Unmarshal(value, &object); err != nil || object.VectorString == "" {
+		return "", fmt.Errorf("field %s is neither a vector string nor an object with vectorString", field)
+	}
+	return object.VectorString, nil
+}
+
+// readBatchCSV 第一行是表头，向量在名称为 field 的列中，列名不区分大小写
+func readBatchCSV(source *batchSource, field string, emit func(record *batchRecord) bool) error {
+	r := csv.NewReader(source.r)
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return &inputError{input: source.name, err: err}
+	}
+	column := -1
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		if strings.EqualFold(strings.TrimSpace(name), field) {
+			column = i
+			break
+		}
+	}
+	if column < 0 {
+		return &inputError{input: source.name, err: fmt.Errorf("column %s not found in header", field)}
+	}
+
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		record := &batchRecord{source: source.name}
+		var parseErr *csv.ParseError
+		switch {
+		case errors.As(err, &parseErr):
+			record.line, record.err = parseErr.StartLine, parseErr.Err
+		case err != nil:
+			return fmt.Errorf("read %s: %w", source.name, err)
+		case column >= len(row):
+			record.line, _ = r.FieldPos(0)
+			record.err = fmt.Errorf("column %s is missing", field)
+		default:
+			record.line, _ = r.FieldPos(column)
+			record.input = strings.TrimSpace(row[column])
+		}
+		if !emit(record) {
+			return nil
+		}
+	}
+}
+
+// batchWriter 批量模式的输出
+type batchWriter interface {
+	write(result *batchResult) error
+	flush() error
+
+	// count 已经输出的记录数
+	count() int
+}
+
+func newBatchWriter(format string, w io.Writer) batchWriter {
+	switch format {
+	case formatCSV:
+		return &csvBatchWriter{w: csv.NewWriter(w)}
+	case formatJSONL:
+		return &jsonlBatchWriter{w: w}
+	default:
+		return &tableBatchWriter{w: tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)}
+	}
+}
+
+// tableFlushRows 表格每输出这么多行就刷新一次，这样在管道中不需要等到输入结束才有输出，内存占用也不会随着输入增长
+const tableFlushRows = 100
+
+// tableBatchWriter 对齐的表格，每 tableFlushRows 行对齐一次并输出，不同批次之间的列宽可能不同
+type tableBatchWriter struct {
+	w *tabwriter.Writer
+	n int
+}
+
+func (x *tableBatchWriter) write(result *batchResult) error {
+	if x.n == 0 {
+		fmt.Fprintf(x.w, "RECORD\tSCORE\tSEVERITY\tVECTOR\n")
+	}
+	x.n++
+	var err error
+	if result.err != nil {
+		_, err = fmt.Fprintf(x.w, "%s\t-\t-\t%s (error: %s)\n", result.record.position(), result.record.input, oneLine(result.err))
+	} else {
+		_, err = fmt.Fprintf(x.w, "%s\t%s\t%s\t%s\n", result.record.position(), formatScore(result.score), result.severity, result.vector)
+	}
+	if err != nil {
+		return err
+	}
+	if x.n%tableFlushRows == 0 {
+		return x.w.Flush()
+	}
+	return nil
+}
+
+func (x *tableBatchWriter) flush() error {
+	return x.w.Flush()
+}
+
+func (x *tableBatchWriter) count() int {
+	return x.n
+}
+
+// 批量模式输出的CSV中除了 csvio 中定义的列之外的列
+const (
+	columnSource   = "source"
+	columnLine     = "line"
+	columnInput    = "input"
+	columnScore    = "score"
+	columnSeverity = "severity"
+	columnError    = "error"
+)
+
+type csvBatchWriter struct {
+	w *csv.Writer
+	n int
+}
+
+func (x *csvBatchWriter) write(result *batchResult) error {
+	if x.n == 0 {
+		if err := x.w.Write([]string{
+			columnSource, columnLine, columnInput, csvio.ColumnVector,
+			columnScore, columnSeverity,
+			csvio.ColumnBaseScore, csvio.ColumnBaseSeverity,
+			csvio.ColumnTemporalScore, csvio.ColumnTemporalSeverity,
+			csvio.ColumnEnvironmentalScore, csvio.ColumnEnvironmentalSeverity,
+			columnError,
+		}); err != nil {
+			return err
+		}
+	}
+	x.n++
+
+	row := []string{result.record.source, strconv.Itoa(result.record.line), result.record.input}
+	if result.err != nil {
+		row = append(row, "", "", "", "", "", "", "", "", "", oneLine(result.err))
+	} else {
+		temporalScore, temporalSeverity, environmentalScore, environmentalSeverity := "", "", "", ""
+		if result.temporal {
+			temporalScore, temporalSeverity = formatScore(result.scores.TemporalScore), string(result.scores.TemporalSeverity)
+		}
+		if result.environmental {
+			environmentalScore, environmentalSeverity = formatScore(result.scores.EnvironmentalScore), string(result.scores.EnvironmentalSeverity)
+		}
+		row = append(row, result.vector,
+			formatScore(result.score), string(result.severity),
+			formatScore(result.scores.BaseScore), string(result.scores.BaseSeverity),
+			temporalScore, temporalSeverity,
+			environmentalScore, environmentalSeverity,
+			"")
+	}
+	return x.w.Write(row)
+}
+
+func (x *csvBatchWriter) flush() error {
+	x.w.Flush()
+	return x.w.Error()
+}
+
+func (x *csvBatchWriter) count() int {
+	return x.n
+}
+
+type jsonlBatchOutput struct {
+	Source                string        `json:"source"`
+	Line                  int           `json:"line"`
+	Input                 string        `json:"input"`
+	Vector                string        `json:"vector,omitempty"`
+	Score                 *float64      `json:"score,omitempty"`
+	Severity              cvss.Severity `json:"severity,omitempty"`
+	BaseScore             *float64      `json:"baseScore,omitempty"`
+	BaseSeverity          cvss.Severity `json:"baseSeverity,omitempty"`
+	TemporalScore         *float64      `json:"temporalScore,omitempty"`
+	TemporalSeverity      cvss.Severity `json:"temporalSeverity,omitempty"`
+	EnvironmentalScore    *float64      `json:"environmentalScore,omitempty"`
+	EnvironmentalSeverity cvss.Severity `json:"environmentalSeverity,omitempty"`
+	Error                 string        `json:"error,omitempty"`
+}
+
+type jsonlBatchWriter struct {
+	w io.Writer
+	n int
+}
+
+func (x *jsonlBatchWriter) write(result *batchResult) error {
+	x.n++
+	output := &jsonlBatchOutput{
+		Source: result.record.source,
+		Line:   result.record.line,
+		Input:  result.record.input,
+	}
+	if result.err != nil {
+		output.Error = result.err.Error()
+		return writeJSON(x.w, output)
+	}
+	output.Vector = result.vector
+	output.Score, output.Severity = &result.score, result.severity
+	output.BaseScore, output.BaseSeverity = &result.scores.BaseScore, result.scores.BaseSeverity
+	if result.temporal {
+		output.TemporalScore, output.TemporalSeverity = &result.scores.TemporalScore, result.scores.TemporalSeverity
+	}
+	if result.environmental {
+		output.EnvironmentalScore, output.EnvironmentalSeverity = &result.scores.EnvironmentalScore, result.scores.EnvironmentalSeverity
+	}
+	return writeJSON(x.w, output)
+}
+
+func (x *jsonlBatchWriter) flush() error {
+	return nil
+}
+
+func (x *jsonlBatchWriter) count() int {
+	return x.n
+}
+
+// oneLine 错误信息中的换行会破坏表格和CSV的行，替换为空格
+func oneLine(err error) string {
+	return strings.Join(strings.Fields(err.Error()), " ")
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runBatchTest(stdin string, args ...string) (int, string, string) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	code := run(append([]string{"batch"}, args...), strings.NewReader(stdin), stdout, stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestBatch_Order(t *testing.T) {
+	// 每条记录的评分都不同，并发处理之后仍然要按照输入的顺序输出
+	values := []string{"N", "A", "L", "P"}
+	lines := make([]string, 0, 400)
+	for i := 0; i < 400; i++ {
+		lines = append(lines, fmt.Sprintf("CVSS:3.1/AV:%s/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", values[i%len(values)]))
+	}
+	code, stdout, stderr := runBatchTest(strings.Join(lines, "\n"), "-format", "jsonl", "-workers", "8")
+	assert.Equal(t, exitOK, code)
+	assert.Empty(t, stderr)
+
+	outputs := strings.Split(strings.TrimSpace(stdout), "\n")
+	require.Len(t, outputs, len(lines))
+	for i, line := range outputs {
+		output := &jsonlBatchOutput{}
+		require.NoError(t, json.Unmarshal([]byte(line), output))
+		assert.Equal(t, i+1, output.Line)
+		assert.Equal(t, lines[i], output.Vector)
+	}
+}
+
+func TestBatch_Lines(t *testing.T) {
+	stdin := testVector + "\n# comment\n\nbogus\n" + testVector + "/E:P\n"
+	code, stdout, stderr := runBatchTest(stdin)
+	assert.Equal(t, exitInvalidInput, code)
+	assert.Contains(t, stdout, "stdin:1  9.8    Critical  "+testVector+"\n")
+	assert.Contains(t, stdout, "stdin:4  -      -         bogus (error: ")
+	assert.Contains(t, stdout, "stdin:5  9.3    Critical  "+testVector+"/E:P\n")
+	assert.Contains(t, stderr, "stdin:4: bogus: ")
+	assert.Contains(t, stderr, "1 of 3 records failed")
+}
+
+func TestBatch_TableFlush(t *testing.T) {
+	// 表格每 tableFlushRows 行就输出一次，不需要等到输入结束
+	buff := &bytes.Buffer{}
+	w := newBatchWriter(formatTable, buff)
+	for i := 1; i < tableFlushRows; i++ {
+		require.NoError(t, w.write(&batchResult{record: &batchRecord{source: "stdin", line: i}, vector: testVector}))
+	}
+	assert.Zero(t, buff.Len())
+	require.NoError(t, w.write(&batchResult{record: &batchRecord{source: "stdin", line: tableFlushRows}, vector: testVector}))
+	assert.Equal(t, tableFlushRows+1, strings.Count(buff.String(), "\n"))
+}
+
+func TestBatch_CSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vectors.csv")
+	content := "\ufeffid,Vector\nA," + testVector + "\nB,CVSS:3.1/AV:N\nC\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	code, stdout, stderr := runBatchTest("", "-format", "csv", path)
+	assert.Equal(t, exitInvalidInput, code)
+	rows, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	assert.Equal(t, []string{path, "2", testVector, testVector, "9.8", "Critical", "9.8", "Critical", "", "", "", "", ""}, rows[1])
+	assert.Equal(t, "3", rows[2][1])
+	assert.Contains(t, rows[2][12], "metric is missing")
+	assert.Equal(t, "column vector is missing", rows[3][12])
+	assert.Contains(t, stderr, "2 of 3 records failed")
+
+	// 表头中没有向量列
+	code, _, stderr = runBatchTest("", "-field", "cvss", path)
+	assert.Equal(t, exitInvalidInput, code)
+	assert.Contains(t, stderr, "column cvss not found in header")
+}
+
+func TestBatch_NDJSON(t *testing.T) {
+	stdin := `{"id":1,"vector":"` + testVector + `"}
+{"id":2,"cvss":{"version":"3.1","vectorString":"` + testVector + `/E:P"}}
+{"version":"3.1","vectorString":"` + testVector + `"}
+not json
+`
+	code, stdout, stderr := runBatchTest(stdin, "-input", "ndjson", "-format", "jsonl")
+	assert.Equal(t, exitInvalidInput, code)
+	outputs := strings.Split(strings.TrimSpace(stdout), "\n")
+	require.Len(t, outputs, 4)
+	assert.Contains(t, outputs[0], `"score":9.8`)
+	assert.Contains(t, outputs[1], `"error":"field vector not found"`)
+	assert.Contains(t, outputs[2], `"vector":"`+testVector+`"`)
+	assert.Contains(t, outputs[3], `"error":`)
+	// 取不出向量时保留原始的一行，方便定位
+	assert.Contains(t, outputs[1], `"input":"{\"id\":2,`)
+	assert.Contains(t, outputs[3], `"input":"not json"`)
+	assert.Contains(t, stderr, "stdin:4: not json: ")
+	assert.Contains(t, stderr, "2 of 4 records failed")
+
+	code, stdout, _ = runBatchTest(stdin, "-input", "ndjson", "-format", "jsonl", "-field", "cvss")
+	assert.Equal(t, exitInvalidInput, code)
+	assert.Contains(t, strings.Split(stdout, "\n")[1], `"temporalScore":9.3`)
+
+	code, stdout, _ = runBatchTest(`{"id":1,"vector":null}`+"\n", "-input", "ndjson", "-format", "jsonl")
+	assert.Equal(t, exitInvalidInput, code)
+	assert.Contains(t, stdout, `"error":"field vector is null"`)
+}
+
+func TestBatch_Errors(t *testing.T) {
+	code, _, _ := runBatchTest("", "-format", "json")
+	assert.Equal(t, exitUsage, code)
+
+	code, _, _ = runBatchTest("", "-input", "xml")
+	assert.Equal(t, exitUsage, code)
+
+	code, _, stderr := runBatchTest("", filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Equal(t, exitInvalidInput, code)
+	assert.Contains(t, stderr, "missing.txt")
+
+	stderr2 := &bytes.Buffer{}
+	code = run([]string{"batch", "-format", "jsonl"}, strings.NewReader(testVector+"\n"), failingWriter{}, stderr2)
+	assert.Equal(t, exitInternal, code)
+}
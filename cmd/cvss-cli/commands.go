@@ -0,0 +1,289 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+	"github.com/scagogogo/cvss-parser/pkg/report"
+)
+
+// command 一个子命令，run 处理一个向量；runArgs 不为nil时由它自己解析命令行参数，比如 batch
+type command struct {
+	name    string
+	summary string
+	run     func(o *options, input string) error
+	runArgs func(o *options, args []string) error
+}
+
+// commands 所有的子命令，canonicalize 是 canonicalise 的别名
+var commands = map[string]*command{
+	"parse":        {name: "parse", summary: "print every metric of the vector", run: runParse},
+	"score":        {name: "score", summary: "print the base, temporal and environmental scores", run: runScore},
+	"validate":     {name: "validate", summary: "check the vector and list every problem found", run: runValidate},
+	"explain":      {name: "explain", summary: "print the scores and describe every metric", run: runExplain},
+	"canonicalise": {name: "canonicalise", summary: "print the vector in canonical form", run: runCanonicalise},
+	"batch":        {name: "batch", summary: batchSummary, runArgs: runBatch},
+}
+
+func findCommand(name string) *command {
+	if name == "canonicalize" {
+		name = "canonicalise"
+	}
+	return commands[name]
+}
+
+// parseInput 解析并校验向量，失败时返回 inputError
+func parseInput(o *options, input string) (*cvss.Cvss3x, error) {
+	cvss3x, err := parser.NewCvss3xParser(strings.TrimSpace(input), o.parserOptions...).Parse()
+	if err == nil {
+		err = cvss3x.Check()
+	}
+	if err != nil {
+		return nil, &inputError{input: input, err: err}
+	}
+	return cvss3x, nil
+}
+
+// metricOutput 一个指标的JSON输出
+type metricOutput struct {
+	Group       string `json:"group,omitempty"`
+	ShortName   string `json:"shortName"`
+	Name        string `json:"name"`
+	ShortValue  string `json:"shortValue"`
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+type parseOutput struct {
+	Input           string          `json:"input"`
+	Vector          string          `json:"vector"`
+	Version         string          `json:"version"`
+	VersionInferred bool            `json:"versionInferred,omitempty"`
+	Metrics         []*metricOutput `json:"metrics"`
+}
+
+func runParse(o *options, input string) error {
+	cvss3x, err := parseInput(o, input)
+	if err != nil {
+		return err
+	}
+	output := &parseOutput{
+		Input:           input,
+		Vector:          cvss3x.String(),
+		Version:         version(cvss3x),
+		VersionInferred: cvss3x.VersionInferred,
+		Metrics:         make([]*metricOutput, 0),
+	}
+	for _, shortName := range cvss.MetricShortNames() {
+		if v := cvss3x.Metric(shortName); v != nil {
+			output.Metrics = append(output.Metrics, &metricOutput{
+				Group:      cvss.MetricGroup(shortName),
+				ShortName:  shortName,
+				Name:       v.GetLongName(),
+				ShortValue: string(v.GetShortValue()),
+				Value:      v.GetLongValue(),
+			})
+		}
+	}
+	if o.format == formatJSON {
+		return writeJSON(o.stdout, output)
+	}
+
+	w := tabwriter.NewWriter(o.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Vector:\t%s\n", output.Vector)
+	fmt.Fprintf(w, "Version:\t%s\n", output.Version)
+	for _, m := range output.Metrics {
+		fmt.Fprintf(w, "%s\t%s (%s)\t%s (%s)\n", m.Group, m.Name, m.ShortName, m.Value, m.ShortValue)
+	}
+	return w.Flush()
+}
+
+func runScore(o *options, input string) error {
+	cvss3x, err := parseInput(o, input)
+	if err != nil {
+		return err
+	}
+	if o.format == formatJSON {
+		// FIRST 的 JSON Schema
+		data, err := cvss3x.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(o.stdout, "%s\n", data)
+		return err
+	}
+
+	scores, err := cvss.NewCalculator(cvss3x).CalculateScores()
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(o.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Vector:\t%s\n", cvss3x.String())
+	fmt.Fprintf(w, "Base Score:\t%s\t%s\n", formatScore(scores.BaseScore), scores.BaseSeverity)
+	if cvss3x.HasTemporal() {
+		fmt.Fprintf(w, "Temporal Score:\t%s\t%s\n", formatScore(scores.TemporalScore), scores.TemporalSeverity)
+	}
+	if cvss3x.HasEnvironmental() {
+		fmt.Fprintf(w, "Environmental Score:\t%s\t%s\n", formatScore(scores.EnvironmentalScore), scores.EnvironmentalSeverity)
+	}
+	return w.Flush()
+}
+
+type validateOutput struct {
+	Input  string   `json:"input"`
+	Valid  bool     `json:"valid"`
+	Vector string   `json:"vector,omitempty"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+// runValidate 校验的结果本身就是输出，所以向量不合法时不会再输出到 stderr ，但是退出码仍然是 exitInvalidInput
+func runValidate(o *options, input string) error {
+	output := &validateOutput{Input: input, Valid: true}
+	cvss3x, err := parseInput(o, input)
+	if err == nil {
+		output.Vector = cvss3x.String()
+	} else {
+		output.Valid = false
+		var validationErrors cvss.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			for _, e := range validationErrors {
+				output.Errors = append(output.Errors, e.Error())
+			}
+		} else {
+			output.Errors = append(output.Errors, errors.Unwrap(err).Error())
+		}
+	}
+
+	if o.format == formatJSON {
+		err = writeJSON(o.stdout, output)
+	} else {
+		err = writeValidateHuman(o.stdout, output)
+	}
+	if err != nil {
+		return err
+	}
+	if !output.Valid {
+		return errInvalidReported
+	}
+	return nil
+}
+
+func writeValidateHuman(w io.Writer, output *validateOutput) error {
+	if output.Valid {
+		_, err := fmt.Fprintf(w, "%s: valid\n", output.Input)
+		return err
+	}
+	buff := &strings.Builder{}
+	fmt.Fprintf(buff, "%s: invalid\n", output.Input)
+	for _, e := range output.Errors {
+		fmt.Fprintf(buff, "  - %s\n", e)
+	}
+	_, err := io.WriteString(w, buff.String())
+	return err
+}
+
+// explainTemplate 在终端中阅读的解释
+const explainTemplate = `CVSS {{ .Version }}: {{ score .Score }} ({{ .Severity }})
+{{ .Vector }}
+{{ range .Groups }}
+{{ .Name }} Metrics: {{ score .Score }} ({{ .Severity }})
+{{ range .Metrics }}  {{ .LongName }} ({{ .ShortName }}): {{ .LongValue }} ({{ .ShortValue }})
+      {{ .Description }}
+{{ end }}{{ end }}`
+
+var explainRenderer = report.MustNewRenderer(explainTemplate)
+
+type explainOutput struct {
+	Input    string                `json:"input"`
+	Vector   string                `json:"vector"`
+	Version  string                `json:"version"`
+	Score    float64               `json:"score"`
+	Severity cvss.Severity         `json:"severity"`
+	Groups   []*explainGroupOutput `json:"groups"`
+}
+
+type explainGroupOutput struct {
+	Name     string          `json:"name"`
+	Score    float64         `json:"score"`
+	Severity cvss.Severity   `json:"severity"`
+	Metrics  []*metricOutput `json:"metrics"`
+}
+
+func runExplain(o *options, input string) error {
+	cvss3x, err := parseInput(o, input)
+	if err != nil {
+		return err
+	}
+	if o.format == formatHuman {
+		return explainRenderer.Render(o.stdout, cvss3x)
+	}
+
+	r, err := report.NewReport(cvss3x)
+	if err != nil {
+		return err
+	}
+	output := &explainOutput{
+		Input:    input,
+		Vector:   r.Vector,
+		Version:  r.Version,
+		Score:    r.Score,
+		Severity: r.Severity,
+		Groups:   make([]*explainGroupOutput, 0, len(r.Groups)),
+	}
+	for _, group := range r.Groups {
+		groupOutput := &explainGroupOutput{Name: group.Name, Score: group.Score, Severity: group.Severity}
+		for _, m := range group.Metrics {
+			groupOutput.Metrics = append(groupOutput.Metrics, &metricOutput{
+				ShortName:   m.ShortName,
+				Name:        m.LongName,
+				ShortValue:  m.ShortValue,
+				Value:       m.LongValue,
+				Description: m.Description,
+			})
+		}
+		output.Groups = append(output.Groups, groupOutput)
+	}
+	return writeJSON(o.stdout, output)
+}
+
+type canonicaliseOutput struct {
+	Input  string `json:"input"`
+	Vector string `json:"vector"`
+}
+
+func runCanonicalise(o *options, input string) error {
+	cvss3x, err := parseInput(o, input)
+	if err != nil {
+		return err
+	}
+	if o.format == formatJSON {
+		return writeJSON(o.stdout, &canonicaliseOutput{Input: input, Vector: cvss3x.String()})
+	}
+	_, err = fmt.Fprintln(o.stdout, cvss3x.String())
+	return err
+}
+
+// writeJSON 输出一行JSON，多个向量时就是 JSON Lines
+func writeJSON(w io.Writer, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+func version(cvss3x *cvss.Cvss3x) string {
+	return fmt.Sprintf("%d.%d", cvss3x.MajorVersion, cvss3x.MinorVersion)
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', 1, 64)
+}
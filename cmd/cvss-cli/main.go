@@ -0,0 +1,243 @@
+// cvss-cli 命令行工具，解析、评分、校验、解释和规范化 CVSS 3.x 向量
+//
+//	cvss-cli score CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+//	cvss-cli explain -format json CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+//	cvss-cli -v1 CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H -detailed
+//	scanner --report | cvss-cli batch -input ndjson -format csv
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+)
+
+// 退出码，调用方可以据此区分是输入的向量有问题还是工具本身出了问题
+const (
+	exitOK = 0
+
+	// 至少有一个向量无法解析或者校验失败
+	exitInvalidInput = 1
+
+	// 命令行参数不对，和 flag 包的约定一致
+	exitUsage = 2
+
+	// 输出失败等内部错误
+	exitInternal = 3
+)
+
+const (
+	formatHuman = "human"
+	formatJSON  = "json"
+)
+
+// formats 单个向量的子命令支持的输出格式
+var formats = []string{formatHuman, formatJSON}
+
+var (
+	// errUsage 命令行参数不对，具体原因已经输出到 stderr
+	errUsage = errors.New("usage error")
+
+	// errInvalidReported 向量不合法，并且已经作为命令的结果输出了，不需要再输出到 stderr
+	errInvalidReported = errors.New("invalid input")
+)
+
+// inputError 输入的向量无法解析或者校验失败
+type inputError struct {
+	input string
+	err   error
+}
+
+func (x *inputError) Error() string {
+	return fmt.Sprintf("%s: %s", x.input, x.err.Error())
+}
+
+func (x *inputError) Unwrap() error {
+	return x.err
+}
+
+// options 所有子命令共用的参数
+type options struct {
+	format         string
+	defaultVersion string
+	parserOptions  []parser.Cvss3xParserOption
+
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// newFlagSet 创建子命令的 FlagSet ，并注册共用的参数，formats 是 -format 允许的取值，第一个是默认值
+func (x *options) newFlagSet(name string, formats ...string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(x.stderr)
+	flags.StringVar(&x.format, "format", formats[0], "output format, "+strings.Join(formats, ", "))
+	flags.StringVar(&x.defaultVersion, "default-version", "", "version used for vectors without a CVSS:3.x prefix, e.g. 3.1")
+	return flags
+}
+
+// init 解析完命令行之后检查共用的参数，formats 和 newFlagSet 的相同
+func (x *options) init(formats ...string) error {
+	if !containsString(formats, x.format) {
+		fmt.Fprintf(x.stderr, "invalid -format %q, must be one of %s\n", x.format, strings.Join(formats, ", "))
+		return errUsage
+	}
+	if x.defaultVersion != "" {
+		majorVersion, minorVersion, err := cvss.ParseVersion(x.defaultVersion)
+		if err != nil {
+			fmt.Fprintf(x.stderr, "invalid -default-version %q, expected e.g. 3.1\n", x.defaultVersion)
+			return errUsage
+		}
+		x.parserOptions = append(x.parserOptions, parser.WithDefaultVersion(majorVersion, minorVersion))
+	}
+	return nil
+}
+
+func containsString(slice []string, s string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run 执行命令并返回退出码
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	o := &options{stdin: stdin, stdout: stdout, stderr: stderr}
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+
+	// 以 - 开头时是老的用法，比如 -v1 CVSS:3.1/... -detailed
+	if strings.HasPrefix(args[0], "-") && args[0] != "-h" && args[0] != "-help" && args[0] != "--help" {
+		return exitCode(o, runLegacy(o, args))
+	}
+
+	name, args := args[0], args[1:]
+	if name == "help" || name == "-h" || name == "-help" || name == "--help" {
+		usage(stdout)
+		return exitOK
+	}
+	cmd := findCommand(name)
+	if cmd == nil {
+		fmt.Fprintf(stderr, "unknown command %q\n\n", name)
+		usage(stderr)
+		return exitUsage
+	}
+	if cmd.runArgs != nil {
+		return exitCode(o, cmd.runArgs(o, args))
+	}
+
+	flags := o.newFlagSet(cmd.name, formats...)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: cvss-cli %s [flags] <vector>...\n\n%s\n\nflags:\n", cmd.name, cmd.summary)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if err := o.init(formats...); err != nil {
+		return exitCode(o, err)
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return exitUsage
+	}
+	return exitCode(o, runCommand(o, cmd, flags.Args()))
+}
+
+// runCommand 对每个向量执行命令，一个向量出错时继续处理后面的向量，返回最严重的错误
+func runCommand(o *options, cmd *command, inputs []string) error {
+	var result error
+	for _, input := range inputs {
+		err := cmd.run(o, input)
+		if err == nil {
+			continue
+		}
+		var e *inputError
+		switch {
+		case errors.Is(err, errInvalidReported):
+		case errors.As(err, &e):
+			fmt.Fprintf(o.stderr, "cvss-cli: %s\n", err)
+		default:
+			// 内部错误一般是输出失败，后面的向量也无法输出了
+			return err
+		}
+		result = err
+	}
+	return result
+}
+
+// runLegacy Makefile 中的用法，-v1 指定向量，-detailed 时输出每个指标的解释，否则只输出评分
+func runLegacy(o *options, args []string) error {
+	flags := o.newFlagSet("cvss-cli", formats...)
+	vector := flags.String("v1", "", "CVSS 3.x vector")
+	detailed := flags.Bool("detailed", false, "explain every metric instead of printing the scores only")
+	flags.Usage = func() { usage(o.stderr) }
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+	if err := o.init(formats...); err != nil {
+		return err
+	}
+	if *vector == "" || flags.NArg() != 0 {
+		usage(o.stderr)
+		return errUsage
+	}
+
+	name := "score"
+	if *detailed {
+		name = "explain"
+	}
+	return runCommand(o, findCommand(name), []string{*vector})
+}
+
+func exitCode(o *options, err error) int {
+	var e *inputError
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, errUsage):
+		return exitUsage
+	case errors.Is(err, errInvalidReported), errors.As(err, &e):
+		return exitInvalidInput
+	default:
+		fmt.Fprintf(o.stderr, "cvss-cli: internal error: %s\n", err)
+		return exitInternal
+	}
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "usage: cvss-cli <command> [flags] <vector>...\n       cvss-cli batch [flags] [file...]\n\ncommands:\n")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-14s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintf(w, "\nflags of every command, cvss-cli <command> -h lists the others:\n")
+	fmt.Fprintf(w, "  -format %-13s output format (default %s), batch: %s (default %s)\n",
+		strings.Join(formats, "|"), formats[0], strings.Join(batchFormats, "|"), batchFormats[0])
+	fmt.Fprintf(w, "  -default-version 3.1  version used for vectors without a CVSS:3.x prefix\n")
+	fmt.Fprintf(w, "\nlegacy usage:\n  cvss-cli -v1 <vector> [-detailed]\n")
+	fmt.Fprintf(w, "\nexit codes: %d ok, %d invalid vector, %d usage error, %d internal error\n",
+		exitOK, exitInvalidInput, exitUsage, exitInternal)
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testVector = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+
+func runTest(args ...string) (int, string, string) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	code := run(args, strings.NewReader(""), stdout, stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_Score(t *testing.T) {
+	code, stdout, stderr := runTest("score", testVector+"/E:P")
+	assert.Equal(t, exitOK, code)
+	assert.Empty(t, stderr)
+	assert.Contains(t, stdout, "Base Score:      9.8  Critical")
+	assert.Contains(t, stdout, "Temporal Score:  9.3  Critical")
+
+	code, stdout, _ = runTest("score", "-format", "json", testVector)
+	assert.Equal(t, exitOK, code)
+	data := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal([]byte(stdout), &data))
+	assert.Equal(t, 9.8, data["baseScore"])
+	assert.Equal(t, "CRITICAL", data["baseSeverity"])
+}
+
+func TestRun_Legacy(t *testing.T) {
+	code, stdout, _ := runTest("-v1", testVector)
+	assert.Equal(t, exitOK, code)
+	assert.Contains(t, stdout, "Base Score:")
+
+	code, stdout, _ = runTest("-v1", testVector, "-detailed")
+	assert.Equal(t, exitOK, code)
+	assert.Contains(t, stdout, "CVSS 3.1: 9.8 (Critical)")
+	assert.Contains(t, stdout, "Attack Vector (AV): Network (N)")
+
+	code, _, _ = runTest("-detailed")
+	assert.Equal(t, exitUsage, code)
+}
+
+func TestRun_Validate(t *testing.T) {
+	code, stdout, stderr := runTest("validate", testVector, "CVSS:3.1/AV:N")
+	assert.Equal(t, exitInvalidInput, code)
+	assert.Empty(t, stderr)
+	assert.Contains(t, stdout, testVector+": valid\n")
+	assert.Contains(t, stdout, "CVSS:3.1/AV:N: invalid\n  - AttackComplexity (AC): metric is missing\n")
+
+	code, stdout, _ = runTest("validate", "-format", "json", "CVSS:3.1/AV:Q")
+	assert.Equal(t, exitInvalidInput, code)
+	output := &validateOutput{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), output))
+	assert.False(t, output.Valid)
+	assert.Len(t, output.Errors, 1)
+
+	// 重复的指标是一个问题，而不是静默地使用最后一个
+	code, stdout, _ = runTest("validate", "-format", "json", testVector+"/AV:L")
+	assert.Equal(t, exitInvalidInput, code)
+	output = &validateOutput{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), output))
+	assert.False(t, output.Valid)
+	require.Len(t, output.Errors, 1)
+	assert.Contains(t, output.Errors[0], "AV appears more than once")
+}
+
+func TestRun_ParseAndCanonicalise(t *testing.T) {
+	code, stdout, _ := runTest("parse", "-format", "json", testVector)
+	assert.Equal(t, exitOK, code)
+	output := &parseOutput{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), output))
+	assert.Equal(t, "3.1", output.Version)
+	assert.Len(t, output.Metrics, 8)
+
+	code, stdout, _ = runTest("canonicalize", "-default-version", "3.0", "A:H/I:H/C:H/S:U/UI:N/PR:N/AC:L/AV:N")
+	assert.Equal(t, exitOK, code)
+	assert.Equal(t, "CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H\n", stdout)
+}
+
+func TestRun_Explain(t *testing.T) {
+	code, stdout, _ := runTest("explain", "-format", "json", testVector)
+	assert.Equal(t, exitOK, code)
+	output := &explainOutput{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), output))
+	assert.Equal(t, 9.8, output.Score)
+	require.Len(t, output.Groups, 1)
+	assert.NotEmpty(t, output.Groups[0].Metrics[0].Description)
+}
+
+func TestRun_ExitCodes(t *testing.T) {
+	// 一个向量不合法时继续处理后面的向量
+	code, stdout, stderr := runTest("canonicalise", "bogus", testVector)
+	assert.Equal(t, exitInvalidInput, code)
+	assert.Equal(t, testVector+"\n", stdout)
+	assert.Contains(t, stderr, "cvss-cli: bogus:")
+
+	code, _, _ = runTest("nope", testVector)
+	assert.Equal(t, exitUsage, code)
+
+	code, _, _ = runTest("score", "-format", "xml", testVector)
+	assert.Equal(t, exitUsage, code)
+
+	code, _, _ = runTest("score")
+	assert.Equal(t, exitUsage, code)
+
+	stderr2 := &bytes.Buffer{}
+	code = run([]string{"score", testVector}, strings.NewReader(""), failingWriter{}, stderr2)
+	assert.Equal(t, exitInternal, code)
+	assert.Contains(t, stderr2.String(), "internal error")
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("broken pipe")
+}
+
+func TestRun_Usage(t *testing.T) {
+	code, stdout, _ := runTest("help")
+	assert.Equal(t, exitOK, code)
+	assert.Contains(t, stdout, "  batch          "+batchSummary+"\n")
+	assert.Contains(t, stdout, "batch: table|csv|jsonl")
+
+	code, _, stderr := runTest("batch", "-h")
+	assert.Equal(t, exitOK, code)
+	assert.Contains(t, stderr, "usage: cvss-cli batch [flags] [file...]")
+}
@@ -0,0 +1,76 @@
+package badge
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+)
+
+// SeverityColors 每个严重性等级使用的颜色
+var SeverityColors = map[cvss.Severity]string{
+	cvss.SeverityNone:     "#9f9f9f",
+	cvss.SeverityLow:      "#4c9a2a",
+	cvss.SeverityMedium:   "#dfb317",
+	cvss.SeverityHigh:     "#fe7d37",
+	cvss.SeverityCritical: "#e05d44",
+}
+
+const (
+	// 左边标签部分的颜色
+	labelColor = "#555"
+
+	// 字体是 11px 的 Verdana ，按照平均字符宽度估算文字的宽度，不需要精确
+	charWidth     = 7
+	badgePadding  = 6
+	badgeHeight   = 20
+	badgeFontSize = 11
+)
+
+// ScoreBadge 生成一个 shields 风格的评分徽章，比如左边是 "CVSS 3.1" ，右边是按照严重性着色的 "9.8 Critical" 。
+// 评分使用 Calculator.Calculate ，向量不合法时返回校验错误
+func ScoreBadge(cvss3x *cvss.Cvss3x) (string, error) {
+	score, err := cvss.NewCalculator(cvss3x).Calculate()
+	if err != nil {
+		return "", err
+	}
+	severity := cvss.SeverityOf(score)
+	label := fmt.Sprintf("CVSS %d.%d", cvss3x.MajorVersion, cvss3x.MinorVersion)
+	message := fmt.Sprintf("%s %s", strconv.FormatFloat(score, 'f', 1, 64), severity)
+	return NewBadge(label, message, SeverityColors[severity]), nil
+}
+
+// NewBadge 生成一个 shields 风格的徽章，左边是灰色的标签，右边是给定颜色的内容
+func NewBadge(label, message, color string) string {
+	labelWidth := textWidth(label)
+	messageWidth := textWidth(message)
+	width := labelWidth + messageWidth
+
+	buff := &strings.Builder{}
+	fmt.Fprintf(buff, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" role="img" aria-label="%s: %s">`,
+		width, badgeHeight, escape(label), escape(message))
+	fmt.Fprintf(buff, `<title>%s: %s</title>`, escape(label), escape(message))
+	fmt.Fprintf(buff, `<linearGradient id="s" x2="0" y2="100%%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`)
+	fmt.Fprintf(buff, `<clipPath id="r"><rect width="%d" height="%d" rx="3" fill="#fff"/></clipPath>`, width, badgeHeight)
+	fmt.Fprintf(buff, `<g clip-path="url(#r)">`)
+	fmt.Fprintf(buff, `<rect width="%d" height="%d" fill="%s"/>`, labelWidth, badgeHeight, labelColor)
+	fmt.Fprintf(buff, `<rect x="%d" width="%d" height="%d" fill="%s"/>`, labelWidth, messageWidth, badgeHeight, escape(color))
+	fmt.Fprintf(buff, `<rect width="%d" height="%d" fill="url(#s)"/>`, width, badgeHeight)
+	fmt.Fprintf(buff, `</g>`)
+	fmt.Fprintf(buff, `<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="%d">`, badgeFontSize)
+	fmt.Fprintf(buff, `<text x="%d" y="14">%s</text>`, labelWidth/2, escape(label))
+	fmt.Fprintf(buff, `<text x="%d" y="14">%s</text>`, labelWidth+messageWidth/2, escape(message))
+	fmt.Fprintf(buff, `</g></svg>`)
+	return buff.String()
+}
+
+func textWidth(s string) int {
+	return len([]rune(s))*charWidth + 2*badgePadding
+}
+
+var escaper = strings.NewReplacer(`&`, "&amp;", `<`, "&lt;", `>`, "&gt;", `"`, "&quot;", `'`, "&#39;")
+
+func escape(s string) string {
+	return escaper.Replace(s)
+}
@@ -0,0 +1,85 @@
+package badge
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, s string) *cvss.Cvss3x {
+	cvss3x, err := parser.NewCvss3xParser(s).Parse()
+	require.NoError(t, err)
+	return cvss3x
+}
+
+// assertWellFormed 检查生成的SVG是合法的XML
+func assertWellFormed(t *testing.T, svg string) {
+	decoder := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		_, err := decoder.Token()
+		if err != nil {
+			assert.Equal(t, "EOF", err.Error())
+			return
+		}
+	}
+}
+
+func TestScoreBadge(t *testing.T) {
+	svg, err := ScoreBadge(mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"))
+	require.NoError(t, err)
+	assertWellFormed(t, svg)
+	assert.Contains(t, svg, ">CVSS 3.1<")
+	assert.Contains(t, svg, ">9.8 Critical<")
+	assert.Contains(t, svg, SeverityColors[cvss.SeverityCritical])
+
+	// 设置了时间指标时使用时间评分
+	svg, err = ScoreBadge(mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:P"))
+	require.NoError(t, err)
+	assert.Contains(t, svg, ">9.3 Critical<")
+
+	_, err = ScoreBadge(&cvss.Cvss3x{MajorVersion: 3, MinorVersion: 1})
+	assert.Error(t, err)
+}
+
+func TestNewBadge(t *testing.T) {
+	svg := NewBadge("a&b", "<x>", "#000")
+	assertWellFormed(t, svg)
+	assert.Contains(t, svg, ">a&amp;b<")
+	assert.Contains(t, svg, ">&lt;x&gt;<")
+}
+
+func TestRadarAxes(t *testing.T) {
+	axes, err := RadarAxes(mustParse(t, "CVSS:3.1/AV:L/AC:L/PR:N/UI:R/S:C/C:H/I:L/A:N"))
+	require.NoError(t, err)
+	require.Len(t, axes, 8)
+
+	values := make(map[string]float64)
+	for _, axis := range axes {
+		values[axis.ShortName] = axis.Value
+	}
+	assert.InDelta(t, 0.55/0.85, values["AV"], 0.0001)
+	assert.InDelta(t, 1, values["AC"], 0.0001)
+	assert.InDelta(t, 1, values["PR"], 0.0001)
+	assert.InDelta(t, 0.62/0.85, values["UI"], 0.0001)
+	assert.InDelta(t, 1, values["S"], 0.0001)
+	assert.InDelta(t, 1, values["C"], 0.0001)
+	assert.InDelta(t, 0.22/0.56, values["I"], 0.0001)
+	assert.InDelta(t, 0, values["A"], 0.0001)
+}
+
+func TestRadar(t *testing.T) {
+	svg, err := Radar(mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:L/I:L/A:N"))
+	require.NoError(t, err)
+	assertWellFormed(t, svg)
+	assert.Contains(t, svg, SeverityColors[cvss.SeverityMedium])
+	assert.Contains(t, svg, ">AV:N<")
+	assert.Contains(t, svg, "Attack Vector: Network")
+
+	_, err = Radar(&cvss.Cvss3x{MajorVersion: 3, MinorVersion: 1})
+	assert.Error(t, err)
+}
@@ -0,0 +1,127 @@
+package badge
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+)
+
+// radarBaseMetrics 雷达图的8个轴，按照向量字符串中的顺序，从正上方开始顺时针排列
+var radarBaseMetrics = []string{"AV", "AC", "PR", "UI", "S", "C", "I", "A"}
+
+const (
+	radarSize   = 320
+	radarRadius = 110
+	radarLevels = 4
+)
+
+// RadarAxis 雷达图的一个轴
+type RadarAxis struct {
+	ShortName string
+	LongName  string
+	Vector    vector.Vector
+
+	// 归一化之后的权重，0到1之间，越大越严重
+	Value float64
+}
+
+// RadarAxes 计算每个基础指标归一化之后的权重，也就是权重除以这个指标所有取值中最大的权重。
+// Scope 的权重都是0，使用 vector.SeverityRank 归一化，也就是 Unchanged 为0，Changed 为1。
+// PR 使用规范中 Scope 为 Unchanged 时的权重。向量不合法时返回校验错误
+func RadarAxes(cvss3x *cvss.Cvss3x) ([]*RadarAxis, error) {
+	if err := cvss3x.Check(); err != nil {
+		return nil, err
+	}
+
+	axes := make([]*RadarAxis, 0, len(radarBaseMetrics))
+	for _, shortName := range radarBaseMetrics {
+		v := cvss3x.Metric(shortName)
+		axes = append(axes, &RadarAxis{
+			ShortName: shortName,
+			LongName:  v.GetLongName(),
+			Vector:    v,
+			Value:     normalisedWeight(v),
+		})
+	}
+	return axes, nil
+}
+
+func normalisedWeight(v vector.Vector) float64 {
+	values := cvss.MetricValues(v.GetShortName())
+	maxWeight, maxRank := 0.0, 0
+	for _, value := range values {
+		maxWeight = math.Max(maxWeight, value.GetScore())
+		if rank, ok := vector.SeverityRank(value); ok && rank > maxRank {
+			maxRank = rank
+		}
+	}
+	if maxWeight > 0 {
+		return v.GetScore() / maxWeight
+	}
+	rank, ok := vector.SeverityRank(v)
+	if !ok || maxRank == 0 {
+		return 0
+	}
+	return float64(rank) / float64(maxRank)
+}
+
+// Radar 生成基础指标的雷达图，每个轴是一个基础指标归一化之后的权重，填充颜色使用基础评分的严重性等级对应的颜色
+func Radar(cvss3x *cvss.Cvss3x) (string, error) {
+	axes, err := RadarAxes(cvss3x)
+	if err != nil {
+		return "", err
+	}
+	baseScore, err := cvss.NewCalculator(cvss3x).CalculateBaseScore()
+	if err != nil {
+		return "", err
+	}
+	color := SeverityColors[cvss.SeverityOf(baseScore)]
+
+	center := float64(radarSize) / 2
+	point := func(i int, value float64) (float64, float64) {
+		angle := -math.Pi/2 + 2*math.Pi*float64(i)/float64(len(axes))
+		return center + value*radarRadius*math.Cos(angle), center + value*radarRadius*math.Sin(angle)
+	}
+	polygon := func(value func(i int) float64) string {
+		points := make([]string, 0, len(axes))
+		for i := range axes {
+			x, y := point(i, value(i))
+			points = append(points, fmt.Sprintf("%.1f,%.1f", x, y))
+		}
+		return strings.Join(points, " ")
+	}
+
+	buff := &strings.Builder{}
+	fmt.Fprintf(buff, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" role="img" aria-label="%s">`,
+		radarSize, radarSize, radarSize, radarSize, escape(cvss3x.String()))
+	fmt.Fprintf(buff, `<title>%s</title>`, escape(cvss3x.String()))
+
+	// 网格和轴
+	fmt.Fprintf(buff, `<g fill="none" stroke="#ccc" stroke-width="1">`)
+	for level := 1; level <= radarLevels; level++ {
+		value := float64(level) / radarLevels
+		fmt.Fprintf(buff, `<polygon points="%s"/>`, polygon(func(int) float64 { return value }))
+	}
+	for i := range axes {
+		x, y := point(i, 1)
+		fmt.Fprintf(buff, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f"/>`, center, center, x, y)
+	}
+	fmt.Fprintf(buff, `</g>`)
+
+	// 数据
+	fmt.Fprintf(buff, `<polygon points="%s" fill="%s" fill-opacity="0.4" stroke="%s" stroke-width="2"/>`,
+		polygon(func(i int) float64 { return axes[i].Value }), color, color)
+
+	// 标签
+	fmt.Fprintf(buff, `<g font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11" fill="#333" text-anchor="middle" dominant-baseline="middle">`)
+	for i, axis := range axes {
+		x, y := point(i, 1.2)
+		fmt.Fprintf(buff, `<text x="%.1f" y="%.1f"><title>%s: %s</title>%s:%c</text>`,
+			x, y, escape(axis.LongName), escape(axis.Vector.GetLongValue()), escape(axis.ShortName), axis.Vector.GetShortValue())
+	}
+	fmt.Fprintf(buff, `</g></svg>`)
+	return buff.String(), nil
+}
@@ -0,0 +1,131 @@
+package csaf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+)
+
+// Document 一个 CSAF 2.0 文档，只解析漏洞的评分，https://docs.oasis-open.org/csaf/csaf/v2.0/csaf-v2.0.html
+type Document struct {
+	Vulnerabilities []*Vulnerability `json:"vulnerabilities,omitempty"`
+}
+
+type Vulnerability struct {
+	CVE    string   `json:"cve,omitempty"`
+	Scores []*Score `json:"scores,omitempty"`
+}
+
+// Score 一组产品的评分，cvss_v3 是 FIRST JSON Schema 格式的对象
+type Score struct {
+	Products []string        `json:"products"`
+	CvssV3   json.RawMessage `json:"cvss_v3,omitempty"`
+}
+
+// ProductScore 一个产品的 CVSS 3.x 评分
+type ProductScore struct {
+	CVE       string
+	ProductID string
+	Cvss3x    *cvss.Cvss3x
+
+	// 文档中声明的评分
+	Declared *cvss.DeclaredScore
+}
+
+// Read 读取 CSAF JSON 文档中所有产品的 CVSS 3.x 评分，一个评分对应多个产品时每个产品返回一项，
+// 没有 cvss_v3 的评分会被忽略，向量不合法时返回的错误中包含CVE编号
+func Read(r io.Reader) ([]*ProductScore, error) {
+	document := &Document{}
+	if err := json.NewDecoder(r).Decode(document); err != nil {
+		return nil, err
+	}
+	return document.ProductScores()
+}
+
+// ReadFile 读取 CSAF JSON 文件中所有产品的 CVSS 3.x 评分
+func ReadFile(path string) ([]*ProductScore, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scores, err := Read(file)
+	if err != nil {
+		return nil, fmt.Errorf("csaf file %s: %w", path, err)
+	}
+	return scores, nil
+}
+
+// ProductScores 按照文档中的顺序返回所有产品的 CVSS 3.x 评分
+func (x *Document) ProductScores() ([]*ProductScore, error) {
+	result := make([]*ProductScore, 0)
+	for _, vulnerability := range x.Vulnerabilities {
+		for _, score := range vulnerability.Scores {
+			if len(score.CvssV3) == 0 || string(score.CvssV3) == "null" {
+				continue
+			}
+			cvss3x, declared, err := parser.ParseScoredJSON(score.CvssV3)
+			if err == nil {
+				err = cvss3x.Check()
+			}
+			if err != nil {
+				return nil, fmt.Errorf("csaf vulnerability %s: %w", vulnerability.CVE, err)
+			}
+			for _, productID := range score.Products {
+				result = append(result, &ProductScore{
+					CVE:       vulnerability.CVE,
+					ProductID: productID,
+					Cvss3x:    cvss3x,
+					Declared:  declared,
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+// Verify 根据向量重新计算评分，并和文档中声明的评分比较
+func (x *ProductScore) Verify() (*cvss.ScoreVerification, error) {
+	return cvss.VerifyDeclaredScore(x.Cvss3x, x.Declared)
+}
+
+// Mismatch 一个声明的评分和重新计算的评分不一致的产品
+type Mismatch struct {
+	*ProductScore
+	Verification *cvss.ScoreVerification
+}
+
+// Verify 校验所有产品声明的评分，返回不一致的产品，全部一致时返回空
+func Verify(scores []*ProductScore) ([]*Mismatch, error) {
+	mismatches := make([]*Mismatch, 0)
+	for _, score := range scores {
+		verification, err := score.Verify()
+		if err != nil {
+			return nil, fmt.Errorf("csaf vulnerability %s product %s: %w", score.CVE, score.ProductID, err)
+		}
+		if !verification.OK() {
+			mismatches = append(mismatches, &Mismatch{ProductScore: score, Verification: verification})
+		}
+	}
+	return mismatches, nil
+}
+
+// NewScore 为自己的公告生成一组产品的评分，cvss_v3 使用 Cvss3x.MarshalJSON 输出，评分是重新计算的
+func NewScore(productIDs []string, cvss3x *cvss.Cvss3x) (*Score, error) {
+	if err := cvss3x.Check(); err != nil {
+		return nil, err
+	}
+	cvssV3, err := cvss3x.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return &Score{
+		Products: productIDs,
+		CvssV3:   cvssV3,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package csaf
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadFile 测试读取每个产品的评分
+func TestReadFile(t *testing.T) {
+	scores, err := ReadFile("testdata/advisory.json")
+	require.NoError(t, err)
+	require.Len(t, scores, 3)
+
+	assert.Equal(t, "CVE-2024-0001", scores[0].CVE)
+	assert.Equal(t, "PROD-A", scores[0].ProductID)
+	assert.Equal(t, "PROD-B", scores[1].ProductID)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", scores[1].Cvss3x.String())
+	assert.Equal(t, 9.8, *scores[1].Declared.BaseScore)
+
+	assert.Equal(t, "PROD-C", scores[2].ProductID)
+	assert.Equal(t, 0, scores[2].Cvss3x.MinorVersion)
+}
+
+// TestVerify 测试校验声明的评分
+func TestVerify(t *testing.T) {
+	scores, err := ReadFile("testdata/advisory.json")
+	require.NoError(t, err)
+
+	mismatches, err := Verify(scores)
+	require.NoError(t, err)
+	require.Len(t, mismatches, 1)
+	assert.Equal(t, "PROD-C", mismatches[0].ProductID)
+	// 实际是 5.5 Medium
+	require.Len(t, mismatches[0].Verification.Mismatches, 1)
+	assert.Equal(t, "baseScore declared 6.5 but computed 5.5", mismatches[0].Verification.Mismatches[0].String())
+}
+
+// TestNewScore 测试为自己的公告生成评分
+func TestNewScore(t *testing.T) {
+	cvss3x, err := cvss.NewBuilder(3, 1).Set("AV", 'N').Set("AC", 'H').Set("PR", 'N').Set("UI", 'R').
+		Set("S", 'U').Set("C", 'H').Set("I", 'L').Set("A", 'N').Build()
+	require.NoError(t, err)
+	score, err := NewScore([]string{"PROD-A"}, cvss3x)
+	require.NoError(t, err)
+
+	document := &Document{Vulnerabilities: []*Vulnerability{{CVE: "CVE-2024-0003", Scores: []*Score{score}}}}
+	data, err := json.Marshal(document)
+	require.NoError(t, err)
+
+	scores, err := Read(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, scores, 1)
+	assert.Equal(t, cvss3x.String(), scores[0].Cvss3x.String())
+	assert.Equal(t, cvss.Severity("MEDIUM"), scores[0].Declared.BaseSeverity)
+
+	mismatches, err := Verify(scores)
+	require.NoError(t, err)
+	assert.Empty(t, mismatches)
+
+	_, err = NewScore([]string{"PROD-A"}, &cvss.Cvss3x{MajorVersion: 3, MinorVersion: 1})
+	assert.ErrorIs(t, err, cvss.ErrMetricMissing)
+}
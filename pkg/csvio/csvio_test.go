@@ -0,0 +1,124 @@
+package csvio
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, s string) *cvss.Cvss3x {
+	cvss3x, err := parser.NewCvss3xParser(s).Parse()
+	require.NoError(t, err)
+	return cvss3x
+}
+
+// TestWriter 测试输出指标列和计算出来的评分列
+func TestWriter(t *testing.T) {
+	buff := &bytes.Buffer{}
+	err := NewWriter(buff).WriteAll([]*cvss.Cvss3x{
+		mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"),
+		mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/RL:O/RC:C/MAV:L"),
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, "AV,AC,PR,UI,S,C,I,A,E,RL,RC,CR,IR,AR,MAV,MAC,MPR,MUI,MS,MC,MI,MA,"+
+		"vector,version,versionInferred,baseScore,baseSeverity,temporalScore,temporalSeverity,environmentalScore,environmentalSeverity", lines[0])
+	assert.Equal(t, "N,L,N,N,U,H,H,H,,,,,,,,,,,,,,,"+
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H,3.1,false,9.8,Critical,,,,", lines[1])
+	assert.Equal(t, "N,L,N,N,U,H,H,H,F,O,C,,,,L,,,,,,,,"+
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/RL:O/RC:C/MAV:L,3.1,false,9.8,Critical,9.1,Critical,7.8,High", lines[2])
+
+	// 输出的CSV可以原样读回来
+	cvss3xs, err := NewReader(buff).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, cvss3xs, 2)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/RL:O/RC:C/MAV:L", cvss3xs[1].String())
+}
+
+// TestWriter_VersionRoundTrip 测试版本号和 VersionInferred 可以原样读回来
+func TestWriter_VersionRoundTrip(t *testing.T) {
+	inferred, err := parser.NewCvss3xParser("AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", parser.WithDefaultVersion(3, 0)).Parse()
+	require.NoError(t, err)
+	buff := &bytes.Buffer{}
+	require.NoError(t, NewWriter(buff).WriteAll([]*cvss.Cvss3x{
+		mustParse(t, "CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"),
+		inferred,
+	}))
+	// 推断出来的版本号也输出规范的向量字符串
+	assert.Contains(t, buff.String(), ",CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H,3.0,true,9.8,")
+
+	cvss3xs, err := NewReader(buff).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, cvss3xs, 2)
+	assert.Equal(t, 0, cvss3xs[0].MinorVersion)
+	assert.False(t, cvss3xs[0].VersionInferred)
+	assert.Equal(t, 0, cvss3xs[1].MinorVersion)
+	assert.True(t, cvss3xs[1].VersionInferred)
+
+	// 版本号列和向量的前缀不一致
+	_, err = NewReader(strings.NewReader("vector,version\nCVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H,3.0\n")).Read()
+	assert.ErrorIs(t, err, ErrVersionMismatch)
+}
+
+// TestReader_ColumnMapping 测试读取已有的表格
+func TestReader_ColumnMapping(t *testing.T) {
+	sheet := "\ufeffCVE,Attack Vector,Complexity,Privileges,Interaction,Scope,Conf,Integ,Avail,Spec\n" +
+		"CVE-2021-44228,N,L,N,N,C,H,H,H,3.1\n" +
+		"CVE-2014-0160,N,L,N,N,U,H,N,N,CVSS:3.0\n" +
+		"CVE-0000-0000,N,L,N,N,U,H,N,N,4.0\n"
+	mapping := &ColumnMapping{
+		Version: "spec",
+		Metrics: map[string]string{
+			"AV": "attack vector", "AC": "Complexity", "PR": "Privileges", "UI": "Interaction",
+			"S": "Scope", "C": "Conf", "I": "Integ", "A": "Avail",
+		},
+	}
+	reader := NewReader(strings.NewReader(sheet), WithColumnMapping(mapping))
+
+	cvss3x, err := reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", cvss3x.String())
+
+	cvss3x, err = reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N", cvss3x.String())
+
+	_, err = reader.Read()
+	assert.ErrorIs(t, err, cvss.ErrUnsupportedVersion)
+	assert.ErrorContains(t, err, "csv line 4")
+}
+
+// TestReader_Errors 测试不合法的表格
+func TestReader_Errors(t *testing.T) {
+	_, err := NewReader(strings.NewReader("id,name\n1,foo\n")).ReadAll()
+	assert.ErrorIs(t, err, ErrMissingColumns)
+
+	// 表头不合法时之后的每次读取都返回同一个错误，而不是把数据行当作表头
+	reader := NewReader(strings.NewReader("id,name\nvector,AV\nCVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H,N\n"))
+	_, err = reader.Read()
+	assert.ErrorIs(t, err, ErrMissingColumns)
+	_, err = reader.Read()
+	assert.ErrorIs(t, err, ErrMissingColumns)
+
+	_, err = NewReader(strings.NewReader("vector,versionInferred\nCVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H,maybe\n")).Read()
+	assert.ErrorContains(t, err, "invalid versionInferred maybe")
+
+	reader = NewReader(strings.NewReader("vector\nAV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H\nCVSS:3.1/AV:N\n"),
+		WithDefaultVersion(3, 0))
+	cvss3x, err := reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", cvss3x.String())
+	_, err = reader.Read()
+	assert.ErrorIs(t, err, cvss.ErrMetricMissing)
+	assert.ErrorContains(t, err, "csv line 3")
+
+	_, err = NewReader(strings.NewReader("AV,AC\nNetwork,L\n")).Read()
+	assert.ErrorIs(t, err, cvss.ErrMetricValue)
+}
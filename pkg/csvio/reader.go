@@ -0,0 +1,239 @@
+package csvio
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+)
+
+var (
+	// ErrMissingColumns 表头中既没有向量字符串列，也没有任何指标列
+	ErrMissingColumns = errors.New("csv error, neither vector column nor metric columns found in header")
+
+	// ErrVersionMismatch 版本号列和向量字符串的前缀不一致
+	ErrVersionMismatch = errors.New("csv error, version column does not match vector")
+)
+
+// ColumnMapping 表格中的列名，匹配表头时不区分大小写，为空表示表格中没有这一列
+type ColumnMapping struct {
+
+	// 向量字符串所在的列，这一列不为空时优先使用
+	Vector string
+
+	// 版本号所在的列，比如 3.1 或者 CVSS:3.1 ，单元格为空时使用默认的版本号，此时版本号被认为是推断出来的。
+	// 向量字符串带有前缀时必须和这一列一致
+	Version string
+
+	// 版本号是否是推断出来的，取值是 true 或者 false ，为 true 时即使有版本号也标记 VersionInferred
+	VersionInferred string
+
+	// 每个指标所在的列，key是指标简称，value是列名，单元格中是取值的简写，比如 N
+	Metrics map[string]string
+}
+
+// DefaultColumnMapping 和 Writer 输出的表头一致的映射
+func DefaultColumnMapping() *ColumnMapping {
+	mapping := &ColumnMapping{
+		Vector:          ColumnVector,
+		Version:         ColumnVersion,
+		VersionInferred: ColumnVersionInferred,
+		Metrics:         make(map[string]string),
+	}
+	for _, shortName := range cvss.MetricShortNames() {
+		mapping.Metrics[shortName] = shortName
+	}
+	return mapping
+}
+
+// ReaderOption 读取的可选配置
+type ReaderOption func(x *Reader)
+
+// WithColumnMapping 使用自定义的列名，用于读取已有的表格
+func WithColumnMapping(mapping *ColumnMapping) ReaderOption {
+	return func(x *Reader) {
+		x.mapping = mapping
+	}
+}
+
+// WithDefaultVersion 向量字符串没有 CVSS:3.x 前缀，或者按照指标列读取但是没有版本号时使用的版本号，默认为3.1
+func WithDefaultVersion(majorVersion, minorVersion int) ReaderOption {
+	return func(x *Reader) {
+		x.majorVersion, x.minorVersion = majorVersion, minorVersion
+	}
+}
+
+// Reader 从CSV中读取向量，第一行是表头
+type Reader struct {
+	r       *csv.Reader
+	mapping *ColumnMapping
+
+	majorVersion int
+	minorVersion int
+
+	// 表头解析之后每一列的下标，-1表示没有这一列，表头不合法时之后的每次读取都返回 headerErr
+	headerRead           bool
+	headerErr            error
+	vectorIndex          int
+	versionIndex         int
+	versionInferredIndex int
+	metricIndexes        map[string]int
+}
+
+func NewReader(r io.Reader, options ...ReaderOption) *Reader {
+	x := &Reader{
+		r:            csv.NewReader(r),
+		mapping:      DefaultColumnMapping(),
+		majorVersion: 3,
+		minorVersion: 1,
+	}
+	// 不同的表格列数可能不一致，缺少的列按照空处理
+	x.r.FieldsPerRecord = -1
+	for _, option := range options {
+		option(x)
+	}
+	return x
+}
+
+// Read 读取下一个向量，读取完时返回 io.EOF ，某一行不合法时返回的错误中包含行号，可以继续读取后面的行
+func (x *Reader) Read() (*cvss.Cvss3x, error) {
+	if !x.headerRead {
+		x.headerErr = x.readHeader()
+		x.headerRead = true
+	}
+	if x.headerErr != nil {
+		return nil, x.headerErr
+	}
+
+	record, err := x.r.Read()
+	if err != nil {
+		return nil, err
+	}
+	line, _ := x.r.FieldPos(0)
+	cvss3x, err := x.parseRecord(record)
+	if err != nil {
+		return nil, fmt.Errorf("csv line %d: %w", line, err)
+	}
+	return cvss3x, nil
+}
+
+// ReadAll 读取所有的向量，遇到第一个错误时停止
+func (x *Reader) ReadAll() ([]*cvss.Cvss3x, error) {
+	cvss3xs := make([]*cvss.Cvss3x, 0)
+	for {
+		cvss3x, err := x.Read()
+		if err == io.EOF {
+			return cvss3xs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		cvss3xs = append(cvss3xs, cvss3x)
+	}
+}
+
+func (x *Reader) readHeader() error {
+	header, err := x.r.Read()
+	if err != nil {
+		return err
+	}
+	// Excel 导出的 UTF-8 CSV 会带有BOM
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	indexOf := func(column string) int {
+		if column == "" {
+			return -1
+		}
+		for i, name := range header {
+			if strings.EqualFold(strings.TrimSpace(name), column) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	x.vectorIndex = indexOf(x.mapping.Vector)
+	x.versionIndex = indexOf(x.mapping.Version)
+	x.versionInferredIndex = indexOf(x.mapping.VersionInferred)
+	x.metricIndexes = make(map[string]int)
+	for shortName, column := range x.mapping.Metrics {
+		if i := indexOf(column); i >= 0 {
+			x.metricIndexes[shortName] = i
+		}
+	}
+	if x.vectorIndex < 0 && len(x.metricIndexes) == 0 {
+		return ErrMissingColumns
+	}
+	return nil
+}
+
+func (x *Reader) parseRecord(record []string) (*cvss.Cvss3x, error) {
+	cell := func(i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	majorVersion, minorVersion, versionInferred := x.majorVersion, x.minorVersion, true
+	if version := cell(x.versionIndex); version != "" {
+		var err error
+		if majorVersion, minorVersion, err = cvss.ParseVersion(version); err != nil {
+			return nil, err
+		}
+		versionInferred = false
+	}
+	// 版本号列有值，但是这个版本号本身是推断出来的，比如 Writer 输出的推断版本号
+	declaredInferred := false
+	if inferred := cell(x.versionInferredIndex); inferred != "" {
+		var err error
+		if declaredInferred, err = strconv.ParseBool(inferred); err != nil {
+			return nil, fmt.Errorf("invalid %s %s", x.mapping.VersionInferred, inferred)
+		}
+	}
+
+	if vectorString := cell(x.vectorIndex); vectorString != "" {
+		cvss3x, err := parser.NewCvss3xParser(vectorString, parser.WithDefaultVersion(majorVersion, minorVersion)).Parse()
+		if err != nil {
+			return nil, err
+		}
+		if !cvss3x.VersionInferred && !versionInferred && (cvss3x.MajorVersion != majorVersion || cvss3x.MinorVersion != minorVersion) {
+			return nil, fmt.Errorf("%w: version %d.%d, vector %s", ErrVersionMismatch, majorVersion, minorVersion, vectorString)
+		}
+		// 向量没有前缀但是版本号列有值时，版本号是表格中声明的
+		cvss3x.VersionInferred = cvss3x.VersionInferred && versionInferred || declaredInferred
+		if err := cvss3x.Check(); err != nil {
+			return nil, err
+		}
+		return cvss3x, nil
+	}
+
+	builder := cvss.NewBuilder(majorVersion, minorVersion)
+	for _, shortName := range cvss.MetricShortNames() {
+		i, ok := x.metricIndexes[shortName]
+		if !ok {
+			continue
+		}
+		value := []rune(cell(i))
+		switch len(value) {
+		case 0:
+			continue
+		case 1:
+			builder.Set(shortName, value[0])
+		default:
+			return nil, fmt.Errorf("%w: %s %s", cvss.ErrMetricValue, shortName, string(value))
+		}
+	}
+	cvss3x, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	cvss3x.VersionInferred = versionInferred || declaredInferred
+	return cvss3x, nil
+}
@@ -0,0 +1,107 @@
+package csvio
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+)
+
+// 除了每个指标的简称之外的列
+const (
+	ColumnVector                = "vector"
+	ColumnVersion               = "version"
+	ColumnVersionInferred       = "versionInferred"
+	ColumnBaseScore             = "baseScore"
+	ColumnBaseSeverity          = "baseSeverity"
+	ColumnTemporalScore         = "temporalScore"
+	ColumnTemporalSeverity      = "temporalSeverity"
+	ColumnEnvironmentalScore    = "environmentalScore"
+	ColumnEnvironmentalSeverity = "environmentalSeverity"
+)
+
+// Header 返回 Writer 输出的表头，先是每个指标的简称，然后是向量字符串、版本号、版本号是否是推断出来的和计算出来的评分
+func Header() []string {
+	header := cvss.MetricShortNames()
+	return append(header,
+		ColumnVector, ColumnVersion, ColumnVersionInferred,
+		ColumnBaseScore, ColumnBaseSeverity,
+		ColumnTemporalScore, ColumnTemporalSeverity,
+		ColumnEnvironmentalScore, ColumnEnvironmentalSeverity,
+	)
+}
+
+// Writer 把向量写成CSV，每个向量一行，第一次写入时会先写表头
+type Writer struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		w: csv.NewWriter(w),
+	}
+}
+
+// Write 写入一个向量，向量不合法时返回校验错误。
+// 没有设置的指标对应的列为空，没有设置时间指标或者环境指标时对应的评分列也为空。
+// 向量字符串总是规范的带前缀的写法，VersionInferred 单独一列，这样 Reader 读回来时仍然是推断的版本号
+func (x *Writer) Write(cvss3x *cvss.Cvss3x) error {
+	if !x.wroteHeader {
+		if err := x.w.Write(Header()); err != nil {
+			return err
+		}
+		x.wroteHeader = true
+	}
+
+	scores, err := cvss.NewCalculator(cvss3x).CalculateScores()
+	if err != nil {
+		return err
+	}
+
+	record := make([]string, 0, len(Header()))
+	for _, shortName := range cvss.MetricShortNames() {
+		value := ""
+		if v := cvss3x.Metric(shortName); v != nil {
+			value = string(v.GetShortValue())
+		}
+		record = append(record, value)
+	}
+	record = append(record,
+		cvss3x.String(), fmt.Sprintf("%d.%d", cvss3x.MajorVersion, cvss3x.MinorVersion), strconv.FormatBool(cvss3x.VersionInferred),
+		formatScore(scores.BaseScore), scores.BaseSeverity.String(),
+	)
+	if cvss3x.HasTemporal() {
+		record = append(record, formatScore(scores.TemporalScore), scores.TemporalSeverity.String())
+	} else {
+		record = append(record, "", "")
+	}
+	if cvss3x.HasEnvironmental() {
+		record = append(record, formatScore(scores.EnvironmentalScore), scores.EnvironmentalSeverity.String())
+	} else {
+		record = append(record, "", "")
+	}
+	return x.w.Write(record)
+}
+
+// WriteAll 写入所有的向量并且 Flush
+func (x *Writer) WriteAll(cvss3xs []*cvss.Cvss3x) error {
+	for _, cvss3x := range cvss3xs {
+		if err := x.Write(cvss3x); err != nil {
+			return err
+		}
+	}
+	return x.Flush()
+}
+
+// Flush 把缓冲的数据写入底层的 io.Writer
+func (x *Writer) Flush() error {
+	x.w.Flush()
+	return x.w.Error()
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', 1, 64)
+}
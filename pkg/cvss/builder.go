@@ -0,0 +1,145 @@
+package cvss
+
+import (
+	"fmt"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+)
+
+// Builder 用于在代码中构造Cvss3x，每个指标的设置方法只接受对应类型的向量，
+// 基础指标和修改后的指标可以互换使用，比如 MAV(vector.AttackVectorNetwork) 会被设置为 MAV:N 。
+// 所有的校验都在 Build 的时候进行，返回的错误和对解析结果调用 Check 得到的错误相同
+//
+//	cvss3x, err := cvss.NewBuilder(3, 1).
+//		AV(vector.AttackVectorNetwork).AC(vector.AttackComplexityLow).PR(vector.PrivilegesRequiredNone).
+//		UI(vector.UserInteractionNone).S(vector.ScopeUnchanged).
+//		C(vector.ConfidentialityHigh).I(vector.IntegrityHigh).A(vector.AvailabilityHigh).
+//		Build()
+type Builder struct {
+	cvss3x *Cvss3x
+
+	// 通过 Set 设置时遇到的无法识别的指标
+	errs ValidationErrors
+}
+
+func NewBuilder(majorVersion, minorVersion int) *Builder {
+	return &Builder{
+		cvss3x: &Cvss3x{
+			Cvss3xBase:   &Cvss3xBase{},
+			MajorVersion: majorVersion,
+			MinorVersion: minorVersion,
+		},
+		errs: make(ValidationErrors, 0),
+	}
+}
+
+// Build 校验并返回构造好的Cvss3x，返回的是一份拷贝，Builder可以继续修改后再次Build
+func (x *Builder) Build() (*Cvss3x, error) {
+	cvss3x := x.cvss3x.clone()
+	errs := append(ValidationErrors{}, x.errs...)
+	if err := cvss3x.Check(); err != nil {
+		errs = append(errs, err.(ValidationErrors)...)
+	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return cvss3x, nil
+}
+
+// Set 通过指标简称和取值设置指标，比如 Set("AV", 'N') ，适用于取值来自表单等外部输入的情况
+func (x *Builder) Set(shortName string, shortValue rune) *Builder {
+	m := findCvss3xMetric(shortName)
+	if m == nil {
+		x.errs = append(x.errs, &ValidationError{
+			ShortName: shortName,
+			Value:     fmt.Sprintf("%s:%c", shortName, shortValue),
+			Err:       ErrMetricName,
+		})
+		return x
+	}
+	v := m.valueOf(shortValue)
+	if v == nil {
+		x.errs = append(x.errs, m.newValidationError(fmt.Sprintf("%s:%c", shortName, shortValue), ErrMetricValue))
+		return x
+	}
+	m.set(x.cvss3x, v)
+	return x
+}
+
+// set 按照取值把基础指标和修改后的指标统一为字段对应的向量，找不到对应取值时原样设置，留给Build时报错
+func (x *Builder) set(shortName string, v vector.Vector) *Builder {
+	m := findCvss3xMetric(shortName)
+	if vector.IsNil(v) {
+		v = nil
+	} else if normalized := m.valueOf(v.GetShortValue()); normalized != nil && sameVectorType(normalized, v) {
+		v = normalized
+	}
+	m.set(x.cvss3x, v)
+	return x
+}
+
+// AV 设置 Attack Vector
+func (x *Builder) AV(v *vector.AttackVector) *Builder { return x.set("AV", v) }
+
+// AC 设置 Attack Complexity
+func (x *Builder) AC(v *vector.AttackComplexity) *Builder { return x.set("AC", v) }
+
+// PR 设置 Privileges Required
+func (x *Builder) PR(v *vector.PrivilegesRequired) *Builder { return x.set("PR", v) }
+
+// UI 设置 User Interaction
+func (x *Builder) UI(v *vector.UserInteraction) *Builder { return x.set("UI", v) }
+
+// S 设置 Scope
+func (x *Builder) S(v *vector.Scope) *Builder { return x.set("S", v) }
+
+// C 设置 Confidentiality
+func (x *Builder) C(v *vector.Confidentiality) *Builder { return x.set("C", v) }
+
+// I 设置 Integrity
+func (x *Builder) I(v *vector.Integrity) *Builder { return x.set("I", v) }
+
+// A 设置 Availability
+func (x *Builder) A(v *vector.Availability) *Builder { return x.set("A", v) }
+
+// E 设置 Exploit Code Maturity
+func (x *Builder) E(v *vector.ExploitCodeMaturity) *Builder { return x.set("E", v) }
+
+// RL 设置 Remediation Level
+func (x *Builder) RL(v *vector.RemediationLevel) *Builder { return x.set("RL", v) }
+
+// RC 设置 Report Confidence
+func (x *Builder) RC(v *vector.ReportConfidence) *Builder { return x.set("RC", v) }
+
+// CR 设置 Confidentiality Requirement
+func (x *Builder) CR(v *vector.ConfidentialityRequirement) *Builder { return x.set("CR", v) }
+
+// IR 设置 Integrity Requirement
+func (x *Builder) IR(v *vector.IntegrityRequirement) *Builder { return x.set("IR", v) }
+
+// AR 设置 Availability Requirement
+func (x *Builder) AR(v *vector.AvailabilityRequirement) *Builder { return x.set("AR", v) }
+
+// MAV 设置 Modified Attack Vector
+func (x *Builder) MAV(v *vector.AttackVector) *Builder { return x.set("MAV", v) }
+
+// MAC 设置 Modified Attack Complexity
+func (x *Builder) MAC(v *vector.AttackComplexity) *Builder { return x.set("MAC", v) }
+
+// MPR 设置 Modified Privileges Required
+func (x *Builder) MPR(v *vector.PrivilegesRequired) *Builder { return x.set("MPR", v) }
+
+// MUI 设置 Modified User Interaction
+func (x *Builder) MUI(v *vector.UserInteraction) *Builder { return x.set("MUI", v) }
+
+// MS 设置 Modified Scope
+func (x *Builder) MS(v *vector.Scope) *Builder { return x.set("MS", v) }
+
+// MC 设置 Modified Confidentiality
+func (x *Builder) MC(v *vector.Confidentiality) *Builder { return x.set("MC", v) }
+
+// MI 设置 Modified Integrity
+func (x *Builder) MI(v *vector.Integrity) *Builder { return x.set("MI", v) }
+
+// MA 设置 Modified Availability
+func (x *Builder) MA(v *vector.Availability) *Builder { return x.set("MA", v) }
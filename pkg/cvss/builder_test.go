@@ -0,0 +1,57 @@
+package cvss
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBuilder_Build 测试通过Builder构造向量
+func TestBuilder_Build(t *testing.T) {
+	builder := NewBuilder(3, 1).
+		AV(vector.AttackVectorNetwork).AC(vector.AttackComplexityLow).PR(vector.PrivilegesRequiredNone).
+		UI(vector.UserInteractionNone).S(vector.ScopeUnchanged).
+		C(vector.ConfidentialityHigh).I(vector.IntegrityHigh).A(vector.AvailabilityHigh)
+
+	cvss3x, err := builder.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", cvss3x.String())
+	assert.Nil(t, cvss3x.Cvss3xTemporal)
+
+	// 修改后的指标可以直接使用基础指标的值
+	cvss3x, err = builder.E(vector.ExploitCodeMaturityFunctional).
+		CR(vector.ConfidentialityRequirementHigh).
+		MAV(vector.AttackVectorLocal).
+		MS(vector.ScopeChanged).
+		Build()
+	assert.NoError(t, err)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/CR:H/MAV:L/MS:C", cvss3x.String())
+	assert.Equal(t, vector.ModifiedAttackVectorLocal, cvss3x.ModifiedAttackVector)
+
+	// Build返回的是拷贝，后续的修改不影响之前的结果
+	builder.AV(vector.AttackVectorPhysical)
+	assert.Equal(t, vector.AttackVectorNetwork, cvss3x.AttackVector)
+
+	cvss3x, err = builder.Set("AV", 'A').Set("RL", 'O').Build()
+	assert.NoError(t, err)
+	assert.Equal(t, vector.AttackVectorAdjacent, cvss3x.AttackVector)
+	assert.Equal(t, vector.RemediationLevelOfficialFix, cvss3x.RemediationLevel)
+}
+
+// TestBuilder_BuildError 测试Build时的校验错误
+func TestBuilder_BuildError(t *testing.T) {
+	_, err := NewBuilder(3, 2).AV(vector.AttackVectorNetwork).Set("XX", 'N').Set("AC", 'Z').Build()
+
+	var errs ValidationErrors
+	assert.True(t, errors.As(err, &errs))
+	assert.ErrorIs(t, errs[0], ErrMetricName)
+	assert.ErrorIs(t, errs[1], ErrMetricValue)
+	assert.ErrorIs(t, errs[2], ErrUnsupportedVersion)
+	assert.ErrorIs(t, err, ErrMetricMissing)
+
+	// 和直接构造的结构体调用Check得到的错误相同
+	_, err = NewBuilder(3, 1).Build()
+	assert.Equal(t, (&Cvss3x{Cvss3xBase: &Cvss3xBase{}, MajorVersion: 3, MinorVersion: 1}).Check(), err)
+}
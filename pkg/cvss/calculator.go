@@ -0,0 +1,226 @@
+package cvss
+
+import (
+	"errors"
+	"math"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+)
+
+// ErrCalculatorNilCvss3x 计算器中没有要计算的CVSS
+var ErrCalculatorNilCvss3x = errors.New("cvss calculator error, cvss3x is nil")
+
+// Scores 一个CVSS向量的所有评分
+type Scores struct {
+	BaseScore             float64
+	BaseSeverity          Severity
+	TemporalScore         float64
+	TemporalSeverity      Severity
+	EnvironmentalScore    float64
+	EnvironmentalSeverity Severity
+}
+
+// Calculator 按照 CVSS v3.0 / v3.1 规范计算评分
+type Calculator struct {
+	cvss3x *Cvss3x
+}
+
+func NewCalculator(cvss3x *Cvss3x) *Calculator {
+	return &Calculator{
+		cvss3x: cvss3x,
+	}
+}
+
+// Calculate 计算最终的评分，有环境指标时返回环境评分，有时间指标时返回时间评分，否则返回基础评分
+func (x *Calculator) Calculate() (float64, error) {
+	scores, err := x.CalculateScores()
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case x.cvss3x.HasEnvironmental():
+		return scores.EnvironmentalScore, nil
+	case x.cvss3x.HasTemporal():
+		return scores.TemporalScore, nil
+	default:
+		return scores.BaseScore, nil
+	}
+}
+
+// CalculateScores 一次计算出基础、时间、环境三个评分及其严重性等级
+func (x *Calculator) CalculateScores() (*Scores, error) {
+	if err := x.check(); err != nil {
+		return nil, err
+	}
+	scores := &Scores{
+		BaseScore:          x.baseScore(),
+		TemporalScore:      x.temporalScore(),
+		EnvironmentalScore: x.environmentalScore(),
+	}
+	scores.BaseSeverity = SeverityOf(scores.BaseScore)
+	scores.TemporalSeverity = SeverityOf(scores.TemporalScore)
+	scores.EnvironmentalSeverity = SeverityOf(scores.EnvironmentalScore)
+	return scores, nil
+}
+
+// CalculateBaseScore 计算基础评分
+func (x *Calculator) CalculateBaseScore() (float64, error) {
+	if err := x.check(); err != nil {
+		return 0, err
+	}
+	return x.baseScore(), nil
+}
+
+// CalculateTemporalScore 计算时间评分，没有时间指标时和基础评分相同
+func (x *Calculator) CalculateTemporalScore() (float64, error) {
+	if err := x.check(); err != nil {
+		return 0, err
+	}
+	return x.temporalScore(), nil
+}
+
+// CalculateEnvironmentalScore 计算环境评分，没有环境指标时和时间评分相同
+func (x *Calculator) CalculateEnvironmentalScore() (float64, error) {
+	if err := x.check(); err != nil {
+		return 0, err
+	}
+	return x.environmentalScore(), nil
+}
+
+// GetSeverityRating 获取评分对应的严重性等级
+func (x *Calculator) GetSeverityRating(score float64) string {
+	return string(SeverityOf(score))
+}
+
+func (x *Calculator) check() error {
+	if x.cvss3x == nil {
+		return ErrCalculatorNilCvss3x
+	}
+	return x.cvss3x.Check()
+}
+
+func (x *Calculator) baseScore() float64 {
+	base := x.cvss3x.Cvss3xBase
+	changed := isScopeChanged(base.Scope)
+
+	iss := 1 - (1-base.Confidentiality.GetScore())*(1-base.Integrity.GetScore())*(1-base.Availability.GetScore())
+	var impact float64
+	if changed {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	} else {
+		impact = 6.42 * iss
+	}
+	exploitability := 8.22 * base.AttackVector.GetScore() * base.AttackComplexity.GetScore() *
+		privilegesRequiredWeight(base.PrivilegesRequired, changed) * base.UserInteraction.GetScore()
+
+	if impact <= 0 {
+		return 0
+	}
+	if changed {
+		return x.roundup(math.Min(1.08*(impact+exploitability), 10))
+	}
+	return x.roundup(math.Min(impact+exploitability, 10))
+}
+
+func (x *Calculator) temporalScore() float64 {
+	return x.roundup(x.baseScore() * x.temporalMultiplier())
+}
+
+func (x *Calculator) environmentalScore() float64 {
+	base := x.cvss3x.Cvss3xBase
+	env := x.cvss3x.environmental()
+
+	changed := isScopeChanged(modifiedOrBase(env.ModifiedScope, base.Scope))
+	c := modifiedOrBase(env.ModifiedConfidentiality, base.Confidentiality).GetScore()
+	i := modifiedOrBase(env.ModifiedIntegrity, base.Integrity).GetScore()
+	a := modifiedOrBase(env.ModifiedAvailability, base.Availability).GetScore()
+
+	miss := math.Min(1-
+		(1-notDefinedWeight(env.ConfidentialityRequirement)*c)*
+			(1-notDefinedWeight(env.IntegrityRequirement)*i)*
+			(1-notDefinedWeight(env.AvailabilityRequirement)*a), 0.915)
+
+	var modifiedImpact float64
+	if changed {
+		// 3.1 修正了 3.0 中修改后影响的计算公式
+		if x.cvss3x.MinorVersion == 0 {
+			modifiedImpact = 7.52*(miss-0.029) - 3.25*math.Pow(miss-0.02, 15)
+		} else {
+			modifiedImpact = 7.52*(miss-0.029) - 3.25*math.Pow(miss*0.9731-0.02, 13)
+		}
+	} else {
+		modifiedImpact = 6.42 * miss
+	}
+
+	modifiedExploitability := 8.22 *
+		modifiedOrBase(env.ModifiedAttackVector, base.AttackVector).GetScore() *
+		modifiedOrBase(env.ModifiedAttackComplexity, base.AttackComplexity).GetScore() *
+		privilegesRequiredWeight(modifiedOrBase(env.ModifiedPrivilegesRequired, base.PrivilegesRequired), changed) *
+		modifiedOrBase(env.ModifiedUserInteraction, base.UserInteraction).GetScore()
+
+	if modifiedImpact <= 0 {
+		return 0
+	}
+	if changed {
+		return x.roundup(x.roundup(math.Min(1.08*(modifiedImpact+modifiedExploitability), 10)) * x.temporalMultiplier())
+	}
+	return x.roundup(x.roundup(math.Min(modifiedImpact+modifiedExploitability, 10)) * x.temporalMultiplier())
+}
+
+// temporalMultiplier 时间指标的乘数
+func (x *Calculator) temporalMultiplier() float64 {
+	temporal := x.cvss3x.temporal()
+	return notDefinedWeight(temporal.ExploitCodeMaturity) *
+		notDefinedWeight(temporal.RemediationLevel) *
+		notDefinedWeight(temporal.ReportConfidence)
+}
+
+// roundup 规范中定义的向上取整到一位小数，3.1 为了避免浮点误差改进了算法
+func (x *Calculator) roundup(value float64) float64 {
+	if x.cvss3x.MinorVersion == 0 {
+		return math.Ceil(value*10) / 10
+	}
+	intInput := int64(math.Round(value * 100000))
+	if intInput%10000 == 0 {
+		return float64(intInput) / 100000.0
+	}
+	return float64(intInput/10000+1) / 10.0
+}
+
+// privilegesRequiredWeight Scope 为 Changed 时 PR:L 和 PR:H 的权重会提高
+func privilegesRequiredWeight(v vector.Vector, scopeChanged bool) float64 {
+	if scopeChanged {
+		switch v.GetShortValue() {
+		case 'L':
+			return 0.68
+		case 'H':
+			return 0.5
+		}
+	}
+	return v.GetScore()
+}
+
+func isScopeChanged(v vector.Vector) bool {
+	return v.GetShortValue() == 'C'
+}
+
+// modifiedOrBase 修改后的指标存在并且不是 Not Defined (X) 时优先使用，否则使用基础指标
+func modifiedOrBase(modified, base vector.Vector) vector.Vector {
+	if !isModifiedValue(modified) {
+		return base
+	}
+	return modified
+}
+
+// notDefinedWeight 时间指标和环境需求指标没有设置时按照 Not Defined 处理，权重为 1
+func notDefinedWeight(v vector.Vector) float64 {
+	if vector.IsNil(v) {
+		return 1
+	}
+	return v.GetScore()
+}
+
+// roundToOneDecimal 四舍五入到一位小数，用于比较评分
+func roundToOneDecimal(value float64) float64 {
+	return math.Round(value*10) / 10
+}
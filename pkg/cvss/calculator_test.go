@@ -0,0 +1,87 @@
+package cvss_test
+
+import (
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCalculator_CalculateScores 测试三个评分的计算，期望值来自 FIRST 官方计算器
+func TestCalculator_CalculateScores(t *testing.T) {
+	testCases := []struct {
+		vector        string
+		base          float64
+		temporal      float64
+		environmental float64
+	}{
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", 9.8, 9.8, 9.8},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", 10.0, 10.0, 10.0},
+		{"CVSS:3.1/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H", 7.8, 7.8, 7.8},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N", 6.1, 6.1, 6.1},
+		{"CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:C/C:L/I:L/A:N", 6.4, 6.4, 6.4},
+		{"CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:U/C:N/I:N/A:H", 5.9, 5.9, 5.9},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N", 0, 0, 0},
+		{"CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", 9.8, 9.8, 9.8},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/RL:O/RC:C", 9.8, 9.1, 9.1},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:U/RL:O/RC:U", 9.8, 7.8, 7.8},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/MAV:L", 9.8, 9.8, 8.4},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/MS:C", 9.8, 9.8, 10.0},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/MAV:X/MS:X", 9.8, 9.8, 9.8},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.vector, func(t *testing.T) {
+			cvss3x, err := parser.NewCvss3xParser(tc.vector).Parse()
+			require.NoError(t, err)
+
+			scores, err := cvss.NewCalculator(cvss3x).CalculateScores()
+			require.NoError(t, err)
+			assert.Equal(t, tc.base, scores.BaseScore)
+			assert.Equal(t, tc.temporal, scores.TemporalScore)
+			assert.Equal(t, tc.environmental, scores.EnvironmentalScore)
+			assert.Equal(t, cvss.SeverityOf(tc.base), scores.BaseSeverity)
+		})
+	}
+}
+
+// TestCalculator_Calculate 测试最终评分的选择和非法向量
+func TestCalculator_Calculate(t *testing.T) {
+	cvss3x, _ := parser.NewCvss3xParser("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/RL:O/RC:C").Parse()
+	score, err := cvss.NewCalculator(cvss3x).Calculate()
+	assert.NoError(t, err)
+	assert.Equal(t, 9.1, score)
+
+	cvss3x, _ = parser.NewCvss3xParser("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/MAV:L").Parse()
+	score, err = cvss.NewCalculator(cvss3x).Calculate()
+	assert.NoError(t, err)
+	assert.Equal(t, 8.2, score)
+
+	cvss3x, _ = parser.NewCvss3xParser("CVSS:3.1/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H").Parse()
+	_, err = cvss.NewCalculator(cvss3x).Calculate()
+	assert.ErrorIs(t, err, cvss.ErrMetricMissing)
+
+	_, err = cvss.NewCalculator(nil).Calculate()
+	assert.ErrorIs(t, err, cvss.ErrCalculatorNilCvss3x)
+}
+
+// TestSeverityOf 测试评分到严重性等级的映射
+func TestSeverityOf(t *testing.T) {
+	assert.Equal(t, cvss.SeverityNone, cvss.SeverityOf(0))
+	assert.Equal(t, cvss.SeverityLow, cvss.SeverityOf(0.1))
+	assert.Equal(t, cvss.SeverityLow, cvss.SeverityOf(3.9))
+	assert.Equal(t, cvss.SeverityMedium, cvss.SeverityOf(4.0))
+	assert.Equal(t, cvss.SeverityMedium, cvss.SeverityOf(6.9))
+	assert.Equal(t, cvss.SeverityHigh, cvss.SeverityOf(7.0))
+	assert.Equal(t, cvss.SeverityHigh, cvss.SeverityOf(8.9))
+	assert.Equal(t, cvss.SeverityCritical, cvss.SeverityOf(9.0))
+	assert.Equal(t, "High", cvss.NewCalculator(nil).GetSeverityRating(7.5))
+
+	severity, err := cvss.ParseSeverity("CRITICAL")
+	assert.NoError(t, err)
+	assert.Equal(t, cvss.SeverityCritical, severity)
+	_, err = cvss.ParseSeverity("Severe")
+	assert.Error(t, err)
+}
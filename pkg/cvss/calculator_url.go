@@ -0,0 +1,33 @@
+package cvss
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// FIRSTCalculatorBaseURL FIRST 官方计算器的地址，后面加上版本号，向量放在 # 后面
+	FIRSTCalculatorBaseURL = "https://www.first.org/cvss/calculator/"
+
+	// NVDCalculatorBaseURL NVD 计算器的地址，向量和版本号放在查询参数中
+	NVDCalculatorBaseURL = "https://nvd.nist.gov/vuln-metrics/cvss/v3-calculator"
+)
+
+// FIRSTCalculatorURL 生成预先填好向量的 FIRST 计算器链接，比如
+// https://www.first.org/cvss/calculator/3.1#CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+func (x *Cvss3x) FIRSTCalculatorURL() (string, error) {
+	if err := x.Check(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%d.%d#%s", FIRSTCalculatorBaseURL, x.MajorVersion, x.MinorVersion, x.String()), nil
+}
+
+// NVDCalculatorURL 生成预先填好向量的 NVD 计算器链接，NVD 的向量参数不带 CVSS:3.x 前缀，比如
+// https://nvd.nist.gov/vuln-metrics/cvss/v3-calculator?vector=AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H&version=3.1
+func (x *Cvss3x) NVDCalculatorURL() (string, error) {
+	if err := x.Check(); err != nil {
+		return "", err
+	}
+	prefix := fmt.Sprintf("CVSS:%d.%d/", x.MajorVersion, x.MinorVersion)
+	return fmt.Sprintf("%s?vector=%s&version=%d.%d", NVDCalculatorBaseURL, strings.TrimPrefix(x.String(), prefix), x.MajorVersion, x.MinorVersion), nil
+}
@@ -3,6 +3,8 @@ package cvss
 import (
 	"fmt"
 	"strings"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
 )
 
 // Cvss3x 表示一个3.x的编号
@@ -17,22 +19,118 @@ type Cvss3x struct {
 
 	// 次版本号
 	MinorVersion int
+
+	// 版本号是否是推断出来的，解析没有 CVSS:3.x 前缀的向量时使用了默认版本号则为true
+	VersionInferred bool
 }
 
 func NewCvss3x() *Cvss3x {
 	return &Cvss3x{
-		Cvss3xBase: &Cvss3xBase{},
-		Cvss3xTemporal: &Cvss3xTemporal{},
+		Cvss3xBase:          &Cvss3xBase{},
+		Cvss3xTemporal:      &Cvss3xTemporal{},
 		Cvss3xEnvironmental: &Cvss3xEnvironmental{},
 	}
 }
 
-// Check TODO 2023-5-19 01:44:22 检查CVSS编号是否合法
+// Check 检查CVSS编号是否合法，会检查版本号、每个字段中向量的类型和取值，
+// 基础指标必须全部存在，时间指标和环境指标是可选的，对应的组为nil时跳过。
+// 发现的所有问题会以 ValidationErrors 的形式一起返回，x 为nil时返回 ErrMetricMissing
 func (x *Cvss3x) Check() error {
+	if x == nil {
+		return ValidationErrors{
+			{
+				Value: "cvss3x is nil",
+				Err:   ErrMetricMissing,
+			},
+		}
+	}
+	errs := x.checkVersion()
 	if x.Cvss3xBase == nil {
-		return fmt.Errorf("cvss3x base is nil")
+		errs = append(errs, &ValidationError{
+			Group: GroupBase,
+			Value: "cvss3x base is nil",
+			Err:   ErrMetricMissing,
+		})
+	} else {
+		errs = append(errs, x.checkGroup(GroupBase, true)...)
+	}
+	if x.Cvss3xTemporal != nil {
+		errs = append(errs, x.checkGroup(GroupTemporal, false)...)
+	}
+	if x.Cvss3xEnvironmental != nil {
+		errs = append(errs, x.checkGroup(GroupEnvironmental, false)...)
+	}
+	return errs.err()
+}
+
+// clone 复制一份Cvss3x，三个指标组也会被复制，向量本身是不可变的所以可以共享
+func (x *Cvss3x) clone() *Cvss3x {
+	c := *x
+	if x.Cvss3xBase != nil {
+		base := *x.Cvss3xBase
+		c.Cvss3xBase = &base
+	}
+	if x.Cvss3xTemporal != nil {
+		temporal := *x.Cvss3xTemporal
+		c.Cvss3xTemporal = &temporal
+	}
+	if x.Cvss3xEnvironmental != nil {
+		environmental := *x.Cvss3xEnvironmental
+		c.Cvss3xEnvironmental = &environmental
+	}
+	return &c
+}
+
+// HasTemporal 是否设置了任何一个时间指标
+func (x *Cvss3x) HasTemporal() bool {
+	return x.hasGroup(GroupTemporal)
+}
+
+// HasEnvironmental 是否设置了任何一个环境指标
+func (x *Cvss3x) HasEnvironmental() bool {
+	return x.hasGroup(GroupEnvironmental)
+}
+
+// Metric 根据指标简称读取指标的值，比如 Metric("AV") ，没有设置或者简称不存在时返回nil
+func (x *Cvss3x) Metric(shortName string) vector.Vector {
+	if m := findCvss3xMetric(shortName); m != nil {
+		return nilIfNilVector(m.get(x))
+	}
+	return nil
+}
+
+// MetricShortNames 按照向量字符串中的顺序返回所有指标的简称
+func MetricShortNames() []string {
+	shortNames := make([]string, 0, len(cvss3xMetrics))
+	for _, m := range cvss3xMetrics {
+		shortNames = append(shortNames, m.shortName)
+	}
+	return shortNames
+}
+
+// MetricValues 返回指标所有合法的取值，简称不存在时返回nil
+func MetricValues(shortName string) []vector.Vector {
+	if m := findCvss3xMetric(shortName); m != nil {
+		return append([]vector.Vector(nil), m.values...)
+	}
+	return nil
+}
+
+// MetricGroup 返回指标所属的组，GroupBase、GroupTemporal 或 GroupEnvironmental ，简称不存在时返回空字符串
+func MetricGroup(shortName string) string {
+	if m := findCvss3xMetric(shortName); m != nil {
+		return m.group
+	}
+	return ""
+}
+
+func (x *Cvss3x) hasGroup(group string) bool {
+	for _, m := range cvss3xMetrics {
+		if m.group == group && !vector.IsNil(m.get(x)) {
+			return true
+		}
 	}
-	return x.Cvss3xBase.Check()
+	return false
 }
 
 func (x *Cvss3x) String() string {
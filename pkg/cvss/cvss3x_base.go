@@ -1,7 +1,6 @@
 package cvss
 
 import (
-	"fmt"
 	"github.com/scagogogo/cvss-parser/pkg/vector"
 	"strings"
 )
@@ -33,42 +32,9 @@ type Cvss3xBase struct {
 	Availability vector.Vector
 }
 
-// Check 检查CVSS编号是否合法
+// Check 检查基础指标是否合法，8个基础指标都必须存在，并且类型和取值正确
 func (x *Cvss3xBase) Check() error {
-
-	if x.AttackVector == nil {
-		return fmt.Errorf("Attack Vector can not empty")
-	}
-
-	if x.AttackComplexity == nil {
-		return fmt.Errorf("Attack Complexity can not empty")
-	}
-
-	if x.PrivilegesRequired == nil {
-		return fmt.Errorf("Privileges Required can not empty")
-	}
-
-	if x.UserInteraction == nil {
-		return fmt.Errorf("UserInteraction can not empty")
-	}
-
-	if x.Scope == nil {
-		return fmt.Errorf("Scope can not empty")
-	}
-
-	if x.Confidentiality == nil {
-		return fmt.Errorf("Confidentiality can not empty")
-	}
-
-	if x.Integrity == nil {
-		return fmt.Errorf("Integrity can not empty")
-	}
-
-	if x.Availability == nil {
-		return fmt.Errorf("Availability can not empty")
-	}
-
-	return nil
+	return (&Cvss3x{Cvss3xBase: x}).checkGroup(GroupBase, true).err()
 }
 
 func (x *Cvss3xBase) String() string {
@@ -27,6 +27,11 @@ type Cvss3xEnvironmental struct {
 	ModifiedAvailability vector.Vector
 }
 
+// Check 检查环境指标是否合法，环境指标都是可选的，但是存在时类型和取值必须正确
+func (x *Cvss3xEnvironmental) Check() error {
+	return (&Cvss3x{Cvss3xEnvironmental: x}).checkGroup(GroupEnvironmental, false).err()
+}
+
 func (x *Cvss3xEnvironmental) String() string {
 	slice := make([]string, 0)
 
@@ -0,0 +1,245 @@
+package cvss
+
+import "github.com/scagogogo/cvss-parser/pkg/vector"
+
+const (
+	// GroupBase 基础指标组
+	GroupBase = "Base"
+
+	// GroupTemporal 时间指标组
+	GroupTemporal = "Temporal"
+
+	// GroupEnvironmental 环境指标组
+	GroupEnvironmental = "Environmental"
+)
+
+// cvss3xMetric 描述Cvss3x中的一个指标字段，包括它所属的组、字段名、简称、FIRST JSON Schema 中的字段名以及合法的取值
+type cvss3xMetric struct {
+	group     string
+	field     string
+	shortName string
+	jsonName  string
+	values    []vector.Vector
+	get       func(x *Cvss3x) vector.Vector
+	set       func(x *Cvss3x, v vector.Vector)
+}
+
+// cvss3xMetrics 按照规范中向量字符串的顺序列出了所有的指标
+var cvss3xMetrics = []*cvss3xMetric{
+
+	// Base Metrics
+	{
+		group: GroupBase, field: "AttackVector", shortName: "AV", jsonName: "attackVector",
+		values: []vector.Vector{vector.AttackVectorNetwork, vector.AttackVectorAdjacent, vector.AttackVectorLocal, vector.AttackVectorPhysical},
+		get:    func(x *Cvss3x) vector.Vector { return x.base().AttackVector },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustBase().AttackVector = v },
+	},
+	{
+		group: GroupBase, field: "AttackComplexity", shortName: "AC", jsonName: "attackComplexity",
+		values: []vector.Vector{vector.AttackComplexityLow, vector.AttackComplexityHigh},
+		get:    func(x *Cvss3x) vector.Vector { return x.base().AttackComplexity },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustBase().AttackComplexity = v },
+	},
+	{
+		group: GroupBase, field: "PrivilegesRequired", shortName: "PR", jsonName: "privilegesRequired",
+		values: []vector.Vector{vector.PrivilegesRequiredNone, vector.PrivilegesRequiredLow, vector.PrivilegesRequiredHigh},
+		get:    func(x *Cvss3x) vector.Vector { return x.base().PrivilegesRequired },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustBase().PrivilegesRequired = v },
+	},
+	{
+		group: GroupBase, field: "UserInteraction", shortName: "UI", jsonName: "userInteraction",
+		values: []vector.Vector{vector.UserInteractionNone, vector.UserInteractionRequired},
+		get:    func(x *Cvss3x) vector.Vector { return x.base().UserInteraction },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustBase().UserInteraction = v },
+	},
+	{
+		group: GroupBase, field: "Scope", shortName: "S", jsonName: "scope",
+		values: []vector.Vector{vector.ScopeUnchanged, vector.ScopeChanged},
+		get:    func(x *Cvss3x) vector.Vector { return x.base().Scope },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustBase().Scope = v },
+	},
+	{
+		group: GroupBase, field: "Confidentiality", shortName: "C", jsonName: "confidentialityImpact",
+		values: []vector.Vector{vector.ConfidentialityNone, vector.ConfidentialityLow, vector.ConfidentialityHigh},
+		get:    func(x *Cvss3x) vector.Vector { return x.base().Confidentiality },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustBase().Confidentiality = v },
+	},
+	{
+		group: GroupBase, field: "Integrity", shortName: "I", jsonName: "integrityImpact",
+		values: []vector.Vector{vector.IntegrityNone, vector.IntegrityLow, vector.IntegrityHigh},
+		get:    func(x *Cvss3x) vector.Vector { return x.base().Integrity },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustBase().Integrity = v },
+	},
+	{
+		group: GroupBase, field: "Availability", shortName: "A", jsonName: "availabilityImpact",
+		values: []vector.Vector{vector.AvailabilityNone, vector.AvailabilityLow, vector.AvailabilityHigh},
+		get:    func(x *Cvss3x) vector.Vector { return x.base().Availability },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustBase().Availability = v },
+	},
+
+	// Temporal Metrics
+	{
+		group: GroupTemporal, field: "ExploitCodeMaturity", shortName: "E", jsonName: "exploitCodeMaturity",
+		values: []vector.Vector{vector.ExploitCodeMaturityNotDefined, vector.ExploitCodeMaturityUnproven, vector.ExploitCodeMaturityProofOfConcept, vector.ExploitCodeMaturityFunctional, vector.ExploitCodeMaturityHigh},
+		get:    func(x *Cvss3x) vector.Vector { return x.temporal().ExploitCodeMaturity },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustTemporal().ExploitCodeMaturity = v },
+	},
+	{
+		group: GroupTemporal, field: "RemediationLevel", shortName: "RL", jsonName: "remediationLevel",
+		values: []vector.Vector{vector.RemediationLevelNotDefined, vector.RemediationLevelOfficialFix, vector.RemediationLevelTemporaryFix, vector.RemediationLevelWorkaround, vector.RemediationLevelUnavailable},
+		get:    func(x *Cvss3x) vector.Vector { return x.temporal().RemediationLevel },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustTemporal().RemediationLevel = v },
+	},
+	{
+		group: GroupTemporal, field: "ReportConfidence", shortName: "RC", jsonName: "reportConfidence",
+		values: []vector.Vector{vector.ReportConfidenceNotDefined, vector.ReportConfidenceUnknown, vector.ReportConfidenceReasonable, vector.ReportConfidenceConfirmed},
+		get:    func(x *Cvss3x) vector.Vector { return x.temporal().ReportConfidence },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustTemporal().ReportConfidence = v },
+	},
+
+	// Environmental Metrics
+	{
+		group: GroupEnvironmental, field: "ConfidentialityRequirement", shortName: "CR", jsonName: "confidentialityRequirement",
+		values: []vector.Vector{vector.ConfidentialityRequirementNotDefined, vector.ConfidentialityRequirementLow, vector.ConfidentialityRequirementMedium, vector.ConfidentialityRequirementHigh},
+		get:    func(x *Cvss3x) vector.Vector { return x.environmental().ConfidentialityRequirement },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustEnvironmental().ConfidentialityRequirement = v },
+	},
+	{
+		group: GroupEnvironmental, field: "IntegrityRequirement", shortName: "IR", jsonName: "integrityRequirement",
+		values: []vector.Vector{vector.IntegrityRequirementNotDefined, vector.IntegrityRequirementLow, vector.IntegrityRequirementMedium, vector.IntegrityRequirementHigh},
+		get:    func(x *Cvss3x) vector.Vector { return x.environmental().IntegrityRequirement },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustEnvironmental().IntegrityRequirement = v },
+	},
+	{
+		group: GroupEnvironmental, field: "AvailabilityRequirement", shortName: "AR", jsonName: "availabilityRequirement",
+		values: []vector.Vector{vector.AvailabilityRequirementNotDefined, vector.AvailabilityRequirementLow, vector.AvailabilityRequirementMedium, vector.AvailabilityRequirementHigh},
+		get:    func(x *Cvss3x) vector.Vector { return x.environmental().AvailabilityRequirement },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustEnvironmental().AvailabilityRequirement = v },
+	},
+	{
+		group: GroupEnvironmental, field: "ModifiedAttackVector", shortName: "MAV", jsonName: "modifiedAttackVector",
+		values: []vector.Vector{vector.ModifiedAttackVectorNetwork, vector.ModifiedAttackVectorAdjacent, vector.ModifiedAttackVectorLocal, vector.ModifiedAttackVectorPhysical, vector.ModifiedAttackVectorNotDefined},
+		get:    func(x *Cvss3x) vector.Vector { return x.environmental().ModifiedAttackVector },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustEnvironmental().ModifiedAttackVector = v },
+	},
+	{
+		group: GroupEnvironmental, field: "ModifiedAttackComplexity", shortName: "MAC", jsonName: "modifiedAttackComplexity",
+		values: []vector.Vector{vector.ModifiedAttackComplexityLow, vector.ModifiedAttackComplexityHigh, vector.ModifiedAttackComplexityNotDefined},
+		get:    func(x *Cvss3x) vector.Vector { return x.environmental().ModifiedAttackComplexity },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustEnvironmental().ModifiedAttackComplexity = v },
+	},
+	{
+		group: GroupEnvironmental, field: "ModifiedPrivilegesRequired", shortName: "MPR", jsonName: "modifiedPrivilegesRequired",
+		values: []vector.Vector{vector.ModifiedPrivilegesRequiredNone, vector.ModifiedPrivilegesRequiredLow, vector.ModifiedPrivilegesRequiredHigh, vector.ModifiedPrivilegesRequiredNotDefined},
+		get:    func(x *Cvss3x) vector.Vector { return x.environmental().ModifiedPrivilegesRequired },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustEnvironmental().ModifiedPrivilegesRequired = v },
+	},
+	{
+		group: GroupEnvironmental, field: "ModifiedUserInteraction", shortName: "MUI", jsonName: "modifiedUserInteraction",
+		values: []vector.Vector{vector.ModifiedUserInteractionNone, vector.ModifiedUserInteractionRequired, vector.ModifiedUserInteractionNotDefined},
+		get:    func(x *Cvss3x) vector.Vector { return x.environmental().ModifiedUserInteraction },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustEnvironmental().ModifiedUserInteraction = v },
+	},
+	{
+		group: GroupEnvironmental, field: "ModifiedScope", shortName: "MS", jsonName: "modifiedScope",
+		values: []vector.Vector{vector.ModifiedScopeUnchanged, vector.ModifiedScopeChanged, vector.ModifiedScopeNotDefined},
+		get:    func(x *Cvss3x) vector.Vector { return x.environmental().ModifiedScope },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustEnvironmental().ModifiedScope = v },
+	},
+	{
+		group: GroupEnvironmental, field: "ModifiedConfidentiality", shortName: "MC", jsonName: "modifiedConfidentialityImpact",
+		values: []vector.Vector{vector.ModifiedConfidentialityNone, vector.ModifiedConfidentialityLow, vector.ModifiedConfidentialityHigh, vector.ModifiedConfidentialityNotDefined},
+		get:    func(x *Cvss3x) vector.Vector { return x.environmental().ModifiedConfidentiality },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustEnvironmental().ModifiedConfidentiality = v },
+	},
+	{
+		group: GroupEnvironmental, field: "ModifiedIntegrity", shortName: "MI", jsonName: "modifiedIntegrityImpact",
+		values: []vector.Vector{vector.ModifiedIntegrityNone, vector.ModifiedIntegrityLow, vector.ModifiedIntegrityHigh, vector.ModifiedIntegrityNotDefined},
+		get:    func(x *Cvss3x) vector.Vector { return x.environmental().ModifiedIntegrity },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustEnvironmental().ModifiedIntegrity = v },
+	},
+	{
+		group: GroupEnvironmental, field: "ModifiedAvailability", shortName: "MA", jsonName: "modifiedAvailabilityImpact",
+		values: []vector.Vector{vector.ModifiedAvailabilityNone, vector.ModifiedAvailabilityLow, vector.ModifiedAvailabilityHigh, vector.ModifiedAvailabilityNotDefined},
+		get:    func(x *Cvss3x) vector.Vector { return x.environmental().ModifiedAvailability },
+		set:    func(x *Cvss3x, v vector.Vector) { x.mustEnvironmental().ModifiedAvailability = v },
+	},
+}
+
+// 下面几个方法在对应的指标组为nil时返回一个空的组，读取的时候不需要判空
+var (
+	emptyCvss3xBase          = &Cvss3xBase{}
+	emptyCvss3xTemporal      = &Cvss3xTemporal{}
+	emptyCvss3xEnvironmental = &Cvss3xEnvironmental{}
+)
+
+func (x *Cvss3x) base() *Cvss3xBase {
+	if x.Cvss3xBase == nil {
+		return emptyCvss3xBase
+	}
+	return x.Cvss3xBase
+}
+
+func (x *Cvss3x) temporal() *Cvss3xTemporal {
+	if x.Cvss3xTemporal == nil {
+		return emptyCvss3xTemporal
+	}
+	return x.Cvss3xTemporal
+}
+
+func (x *Cvss3x) environmental() *Cvss3xEnvironmental {
+	if x.Cvss3xEnvironmental == nil {
+		return emptyCvss3xEnvironmental
+	}
+	return x.Cvss3xEnvironmental
+}
+
+// 下面几个方法在对应的指标组为nil时会先创建它，写入的时候使用
+func (x *Cvss3x) mustBase() *Cvss3xBase {
+	if x.Cvss3xBase == nil {
+		x.Cvss3xBase = &Cvss3xBase{}
+	}
+	return x.Cvss3xBase
+}
+
+func (x *Cvss3x) mustTemporal() *Cvss3xTemporal {
+	if x.Cvss3xTemporal == nil {
+		x.Cvss3xTemporal = &Cvss3xTemporal{}
+	}
+	return x.Cvss3xTemporal
+}
+
+func (x *Cvss3x) mustEnvironmental() *Cvss3xEnvironmental {
+	if x.Cvss3xEnvironmental == nil {
+		x.Cvss3xEnvironmental = &Cvss3xEnvironmental{}
+	}
+	return x.Cvss3xEnvironmental
+}
+
+// findCvss3xMetric 根据指标简称查找指标定义，找不到时返回nil
+func findCvss3xMetric(shortName string) *cvss3xMetric {
+	for _, m := range cvss3xMetrics {
+		if m.shortName == shortName {
+			return m
+		}
+	}
+	return nil
+}
+
+// valueOf 根据取值查找指标的合法向量，找不到时返回nil
+func (x *cvss3xMetric) valueOf(shortValue rune) vector.Vector {
+	if i := x.indexOf(shortValue); i >= 0 {
+		return x.values[i]
+	}
+	return nil
+}
+
+// indexOf 根据取值查找在合法取值中的下标，找不到时返回-1
+func (x *cvss3xMetric) indexOf(shortValue rune) int {
+	for i, v := range x.values {
+		if v.GetShortValue() == shortValue {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,54 @@
+package cvss
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parseCvss3xString 解析标准格式的向量字符串，比如 CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H ，
+// 必须有 CVSS:3.x 前缀，前缀不区分大小写，同一个指标只能出现一次。
+// UnmarshalText、UnmarshalJSON 等反序列化方法都使用它，这样只导入 cvss 包也可以反序列化，
+// 需要更宽松的解析时请使用 parser 包。解析结果没有经过 Check 校验
+func parseCvss3xString(s string) (*Cvss3x, error) {
+	parts := strings.Split(s, "/")
+	if len(parts[0]) < 5 || !strings.EqualFold(parts[0][:5], "CVSS:") {
+		return nil, fmt.Errorf("cvss3x %s syntax error, it must start with 'CVSS:'", s)
+	}
+	// 前缀统一为大写，ParseVersion 只会去掉一个前缀
+	majorVersion, minorVersion, err := ParseVersion("CVSS:" + parts[0][5:])
+	if err != nil {
+		return nil, fmt.Errorf("cvss3x %s syntax error, invalid version %s", s, parts[0])
+	}
+
+	cvss3x := &Cvss3x{
+		Cvss3xBase:   &Cvss3xBase{},
+		MajorVersion: majorVersion,
+		MinorVersion: minorVersion,
+	}
+	seen := make(map[string]bool)
+	for _, part := range parts[1:] {
+		kv := strings.SplitN(part, ":", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("cvss3x %s syntax error, expected KEY:VALUE but got %s", s, part)
+		}
+		m := findCvss3xMetric(kv[0])
+		if m == nil {
+			return nil, fmt.Errorf("cvss3x %s syntax error, %w: %s", s, ErrMetricName, kv[0])
+		}
+		if seen[m.shortName] {
+			return nil, fmt.Errorf("cvss3x %s syntax error, %w: %s", s, ErrMetricDuplicate, m.shortName)
+		}
+		seen[m.shortName] = true
+
+		value := []rune(kv[1])
+		if len(value) != 1 {
+			return nil, fmt.Errorf("cvss3x %s syntax error, %w: %s", s, ErrMetricValue, part)
+		}
+		v := m.valueOf(value[0])
+		if v == nil {
+			return nil, fmt.Errorf("cvss3x %s syntax error, %w: %s", s, ErrMetricValue, part)
+		}
+		m.set(cvss3x, v)
+	}
+	return cvss3x, nil
+}
@@ -11,6 +11,11 @@ type Cvss3xTemporal struct {
 	ReportConfidence    vector.Vector
 }
 
+// Check 检查时间指标是否合法，时间指标都是可选的，但是存在时类型和取值必须正确
+func (x *Cvss3xTemporal) Check() error {
+	return (&Cvss3x{Cvss3xTemporal: x}).checkGroup(GroupTemporal, false).err()
+}
+
 func (x *Cvss3xTemporal) String() string {
 	slice := make([]string, 0)
 
@@ -0,0 +1,190 @@
+package cvss
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCvss3x 构造一个 CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+func newTestCvss3x() *Cvss3x {
+	return &Cvss3x{
+		Cvss3xBase: &Cvss3xBase{
+			AttackVector:       vector.AttackVectorNetwork,
+			AttackComplexity:   vector.AttackComplexityLow,
+			PrivilegesRequired: vector.PrivilegesRequiredNone,
+			UserInteraction:    vector.UserInteractionNone,
+			Scope:              vector.ScopeUnchanged,
+			Confidentiality:    vector.ConfidentialityHigh,
+			Integrity:          vector.IntegrityHigh,
+			Availability:       vector.AvailabilityHigh,
+		},
+		MajorVersion: 3,
+		MinorVersion: 1,
+	}
+}
+
+// TestCvss3x_Check 测试完整的语义校验
+func TestCvss3x_Check(t *testing.T) {
+	testCases := []struct {
+		name     string
+		modify   func(x *Cvss3x)
+		wantErrs []error
+		fields   []string
+	}{
+		{
+			name:   "Valid Base Only",
+			modify: func(x *Cvss3x) {},
+		},
+		{
+			name: "Valid With Temporal And Environmental",
+			modify: func(x *Cvss3x) {
+				x.Cvss3xTemporal = &Cvss3xTemporal{ExploitCodeMaturity: vector.ExploitCodeMaturityFunctional}
+				x.Cvss3xEnvironmental = &Cvss3xEnvironmental{
+					ConfidentialityRequirement: vector.ConfidentialityRequirementHigh,
+					ModifiedScope:              vector.ModifiedScopeChanged,
+				}
+			},
+		},
+		{
+			name:   "Valid CVSS 3.0",
+			modify: func(x *Cvss3x) { x.MinorVersion = 0 },
+		},
+		{
+			name:     "Unsupported Version",
+			modify:   func(x *Cvss3x) { x.MinorVersion = 2 },
+			wantErrs: []error{ErrUnsupportedVersion},
+			fields:   []string{""},
+		},
+		{
+			name: "Missing Base Metrics",
+			modify: func(x *Cvss3x) {
+				x.AttackVector = nil
+				x.Availability = (*vector.Availability)(nil)
+			},
+			wantErrs: []error{ErrMetricMissing, ErrMetricMissing},
+			fields:   []string{"AttackVector", "Availability"},
+		},
+		{
+			name:     "Nil Base Group",
+			modify:   func(x *Cvss3x) { x.Cvss3xBase = nil },
+			wantErrs: []error{ErrMetricMissing},
+			fields:   []string{""},
+		},
+		{
+			name:     "Wrong Type In Base Slot",
+			modify:   func(x *Cvss3x) { x.AttackVector = vector.ScopeChanged },
+			wantErrs: []error{ErrMetricType},
+			fields:   []string{"AttackVector"},
+		},
+		{
+			name: "Base Value In Modified Slot",
+			modify: func(x *Cvss3x) {
+				x.Cvss3xEnvironmental = &Cvss3xEnvironmental{ModifiedScope: vector.ScopeChanged}
+			},
+			wantErrs: []error{ErrMetricName},
+			fields:   []string{"ModifiedScope"},
+		},
+		{
+			name:     "Zero Value Vector",
+			modify:   func(x *Cvss3x) { x.Scope = &vector.Scope{} },
+			wantErrs: []error{ErrMetricName},
+			fields:   []string{"Scope"},
+		},
+		{
+			name: "All Violations Collected",
+			modify: func(x *Cvss3x) {
+				x.MajorVersion = 2
+				x.Integrity = vector.IntegrityRequirementHigh
+				x.Cvss3xTemporal = &Cvss3xTemporal{ReportConfidence: vector.RemediationLevelOfficialFix}
+			},
+			wantErrs: []error{ErrUnsupportedVersion, ErrMetricType, ErrMetricType},
+			fields:   []string{"", "Integrity", "ReportConfidence"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x := newTestCvss3x()
+			tc.modify(x)
+			err := x.Check()
+
+			if len(tc.wantErrs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var errs ValidationErrors
+			assert.True(t, errors.As(err, &errs))
+			assert.Len(t, errs, len(tc.wantErrs))
+			for i, e := range errs {
+				assert.ErrorIs(t, e, tc.wantErrs[i])
+				assert.Equal(t, tc.fields[i], e.Field)
+				assert.ErrorIs(t, err, tc.wantErrs[i])
+			}
+		})
+	}
+}
+
+// TestCvss3x_CheckNil 测试nil不会panic，依赖 Check 的函数因此都可以安全地接收nil
+func TestCvss3x_CheckNil(t *testing.T) {
+	var x *Cvss3x
+	var errs ValidationErrors
+	assert.True(t, errors.As(x.Check(), &errs))
+	assert.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrMetricMissing)
+}
+
+// TestCvss3x_CheckIllegalValue 导出的向量单例都是合法的取值，这里复制一份 Scope 的指标定义并去掉一个合法取值来覆盖 ErrMetricValue ，
+// 不修改全局的 cvss3xMetrics
+func TestCvss3x_CheckIllegalValue(t *testing.T) {
+	m := *findCvss3xMetric("S")
+	m.values = []vector.Vector{vector.ScopeUnchanged}
+
+	x := newTestCvss3x()
+	x.Scope = vector.ScopeChanged
+	err := m.check(m.get(x), true)
+	require.NotNil(t, err)
+	assert.ErrorIs(t, err, ErrMetricValue)
+	assert.Equal(t, "Scope", err.Field)
+	assert.Equal(t, "S:C", err.Value)
+	assert.NoError(t, x.Check())
+}
+
+// TestCvss3xGroups_Check 测试每个指标组单独的校验
+func TestCvss3xGroups_Check(t *testing.T) {
+	assert.NoError(t, newTestCvss3x().Cvss3xBase.Check())
+	assert.ErrorIs(t, (&Cvss3xBase{}).Check(), ErrMetricMissing)
+
+	assert.NoError(t, (&Cvss3xTemporal{}).Check())
+	assert.ErrorIs(t, (&Cvss3xTemporal{ExploitCodeMaturity: vector.ScopeChanged}).Check(), ErrMetricType)
+
+	assert.NoError(t, (&Cvss3xEnvironmental{}).Check())
+	assert.ErrorIs(t, (&Cvss3xEnvironmental{ModifiedAttackVector: vector.AttackVectorLocal}).Check(), ErrMetricName)
+}
+
+// TestParseVersion 测试版本号的格式
+func TestParseVersion(t *testing.T) {
+	for _, s := range []string{"3.1", "CVSS:3.1"} {
+		majorVersion, minorVersion, err := ParseVersion(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, 3, majorVersion)
+		assert.Equal(t, 1, minorVersion)
+	}
+	for _, s := range []string{"", "3", "3.x", "CVSS:CVSS:3.1"} {
+		_, _, err := ParseVersion(s)
+		assert.Error(t, err, s)
+	}
+}
+
+// TestPackedMetricBits 测试压缩布局能容纳所有指标的所有取值
+func TestPackedMetricBits(t *testing.T) {
+	assert.Len(t, packedMetricBits, len(cvss3xMetrics))
+	for i, m := range cvss3xMetrics {
+		assert.LessOrEqual(t, len(m.values)+1, 1<<packedMetricBits[i], m.shortName)
+	}
+	assert.LessOrEqual(t, packedVersionInferredOffset, uint(63))
+}
@@ -0,0 +1,193 @@
+package cvss
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+)
+
+var (
+	// ErrMetricMissing 必需的指标没有设置
+	ErrMetricMissing = errors.New("metric is missing")
+
+	// ErrMetricType 指标字段中存放的向量类型不对，比如在AttackVector字段中放了Scope
+	ErrMetricType = errors.New("metric has wrong type")
+
+	// ErrMetricName 指标字段中存放的向量简称不对，比如在ModifiedScope字段中放了Scope
+	ErrMetricName = errors.New("metric has wrong name")
+
+	// ErrMetricValue 指标的取值在声明的版本中不合法
+	ErrMetricValue = errors.New("metric value is not allowed")
+
+	// ErrMetricDuplicate 向量字符串中同一个指标出现了多次
+	ErrMetricDuplicate = errors.New("metric is repeated")
+
+	// ErrUnsupportedVersion 不支持的CVSS版本
+	ErrUnsupportedVersion = errors.New("unsupported cvss version")
+)
+
+// SupportedMinorVersions 支持的3.x次版本号
+var SupportedMinorVersions = []int{0, 1}
+
+// IsSupportedVersion 判断给定的版本号是否是支持的3.x版本
+func IsSupportedVersion(majorVersion, minorVersion int) bool {
+	if majorVersion != 3 {
+		return false
+	}
+	for _, v := range SupportedMinorVersions {
+		if v == minorVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseVersion 解析 3.1 或者 CVSS:3.1 这样的版本号，只检查格式，是否支持请使用 IsSupportedVersion 判断
+func ParseVersion(s string) (int, int, error) {
+	version := strings.SplitN(strings.TrimPrefix(s, "CVSS:"), ".", 2)
+	if len(version) == 2 {
+		majorVersion, majorErr := strconv.Atoi(version[0])
+		minorVersion, minorErr := strconv.Atoi(version[1])
+		if majorErr == nil && minorErr == nil {
+			return majorVersion, minorVersion, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("invalid cvss version %s", s)
+}
+
+// ValidationError 表示一个校验失败的指标
+type ValidationError struct {
+
+	// 指标所属的组，版本错误时为空
+	Group string
+
+	// 出错的字段名，比如 AttackVector
+	Field string
+
+	// 字段期望的指标简称，比如 AV
+	ShortName string
+
+	// 实际的值，缺失时为空
+	Value string
+
+	// 错误原因，是上面定义的 ErrMetricXXX 或者 ErrUnsupportedVersion 之一
+	Err error
+}
+
+var _ error = &ValidationError{}
+
+func (x *ValidationError) Error() string {
+	if x.Field == "" {
+		return fmt.Sprintf("%s: %s", x.Err.Error(), x.Value)
+	}
+	if x.Value == "" {
+		return fmt.Sprintf("%s (%s): %s", x.Field, x.ShortName, x.Err.Error())
+	}
+	return fmt.Sprintf("%s (%s): %s: %s", x.Field, x.ShortName, x.Err.Error(), x.Value)
+}
+
+func (x *ValidationError) Unwrap() error {
+	return x.Err
+}
+
+// ValidationErrors 是校验时发现的所有问题的列表
+type ValidationErrors []*ValidationError
+
+var _ error = ValidationErrors{}
+
+func (x ValidationErrors) Error() string {
+	slice := make([]string, 0, len(x))
+	for _, e := range x {
+		slice = append(slice, e.Error())
+	}
+	return "cvss3x validation failed: " + strings.Join(slice, "; ")
+}
+
+// Is 只要列表中有一个错误匹配就认为匹配，这样调用方可以用 errors.Is(err, ErrMetricMissing) 判断
+func (x ValidationErrors) Is(target error) bool {
+	for _, e := range x {
+		if errors.Is(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// err 没有错误时返回nil，避免返回一个非nil的空列表
+func (x ValidationErrors) err() error {
+	if len(x) == 0 {
+		return nil
+	}
+	return x
+}
+
+// checkVersion 检查版本号是否是支持的3.x版本
+func (x *Cvss3x) checkVersion() ValidationErrors {
+	if IsSupportedVersion(x.MajorVersion, x.MinorVersion) {
+		return nil
+	}
+	return ValidationErrors{
+		{
+			Value: fmt.Sprintf("CVSS:%d.%d", x.MajorVersion, x.MinorVersion),
+			Err:   ErrUnsupportedVersion,
+		},
+	}
+}
+
+// checkGroup 检查某个指标组下的所有字段，required表示字段是否必须有值
+func (x *Cvss3x) checkGroup(group string, required bool) ValidationErrors {
+	errs := make(ValidationErrors, 0)
+	for _, m := range cvss3xMetrics {
+		if m.group != group {
+			continue
+		}
+		if err := m.check(m.get(x), required); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// check 检查指标字段中的值是否是合法的向量
+func (x *cvss3xMetric) check(v vector.Vector, required bool) *ValidationError {
+	if vector.IsNil(v) {
+		if !required {
+			return nil
+		}
+		return x.newValidationError("", ErrMetricMissing)
+	}
+
+	// 类型必须和合法取值的类型一致，比如AttackVector字段只能放*vector.AttackVector
+	if !sameVectorType(v, x.values[0]) {
+		return x.newValidationError(fmt.Sprintf("%T", v), ErrMetricType)
+	}
+
+	// 同一个类型会同时用于基础指标和修改后的指标，所以还需要检查简称
+	if v.GetShortName() != x.shortName {
+		return x.newValidationError(v.String(), ErrMetricName)
+	}
+
+	if x.valueOf(v.GetShortValue()) == nil {
+		return x.newValidationError(v.String(), ErrMetricValue)
+	}
+	return nil
+}
+
+func (x *cvss3xMetric) newValidationError(value string, err error) *ValidationError {
+	return &ValidationError{
+		Group:     x.group,
+		Field:     x.field,
+		ShortName: x.shortName,
+		Value:     value,
+		Err:       err,
+	}
+}
+
+// sameVectorType 判断两个向量的具体类型是否相同
+func sameVectorType(a, b vector.Vector) bool {
+	return reflect.TypeOf(a) == reflect.TypeOf(b)
+}
@@ -0,0 +1,194 @@
+package cvss
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+)
+
+// ErrDiffNilCvss3x 比较的两个向量中有nil
+var ErrDiffNilCvss3x = errors.New("cvss diff error, cvss3x is nil")
+
+// DiffDirection 一个指标变化后是变得更严重还是更不严重
+type DiffDirection int
+
+const (
+	// DiffDirectionUnknown 无法比较，比如其中一边缺少基础指标
+	DiffDirectionUnknown DiffDirection = iota
+
+	// DiffDirectionEquivalent 取值不同但是对评分的影响相同，比如 E:X 和 E:H
+	DiffDirectionEquivalent
+
+	// DiffDirectionMoreSevere 变得更严重
+	DiffDirectionMoreSevere
+
+	// DiffDirectionLessSevere 变得更不严重
+	DiffDirectionLessSevere
+)
+
+func (x DiffDirection) String() string {
+	switch x {
+	case DiffDirectionEquivalent:
+		return "equivalent"
+	case DiffDirectionMoreSevere:
+		return "more severe"
+	case DiffDirectionLessSevere:
+		return "less severe"
+	default:
+		return "unknown"
+	}
+}
+
+// MetricDiff 一个发生了变化的指标
+type MetricDiff struct {
+
+	// 指标所属的组，GroupBase、GroupTemporal 或 GroupEnvironmental
+	Group string
+
+	// 字段名，比如 AttackVector
+	Field string
+
+	// 指标简称，比如 AV
+	ShortName string
+
+	// 变化前后的值，没有设置时为nil
+	Old vector.Vector
+	New vector.Vector
+
+	Direction DiffDirection
+}
+
+func (x *MetricDiff) String() string {
+	return fmt.Sprintf("%s: %s -> %s (%s)", x.ShortName, diffValueString(x.Old), diffValueString(x.New), x.Direction)
+}
+
+func diffValueString(v vector.Vector) string {
+	if vector.IsNil(v) {
+		return "(not set)"
+	}
+	return string(v.GetShortValue())
+}
+
+// Cvss3xDiff 两个向量之间的差异
+type Cvss3xDiff struct {
+	Old *Cvss3x
+	New *Cvss3x
+
+	// 所有发生了变化的指标，按照向量字符串中的顺序排列
+	Metrics []*MetricDiff
+
+	OldScores *Scores
+	NewScores *Scores
+
+	// 评分的变化，新的减去旧的，保留一位小数
+	BaseScoreDelta          float64
+	TemporalScoreDelta      float64
+	EnvironmentalScoreDelta float64
+}
+
+// Changed 两个向量是否有任何指标不同
+func (x *Cvss3xDiff) Changed() bool {
+	return len(x.Metrics) != 0
+}
+
+// Diff 逐个指标比较两个向量，并计算评分的变化，比如比较厂商公告中的向量和NVD分析后的向量。
+// 两个向量都必须是合法的，否则无法计算评分，会返回校验错误
+func Diff(a, b *Cvss3x) (*Cvss3xDiff, error) {
+	if a == nil || b == nil {
+		return nil, ErrDiffNilCvss3x
+	}
+
+	oldScores, err := NewCalculator(a).CalculateScores()
+	if err != nil {
+		return nil, err
+	}
+	newScores, err := NewCalculator(b).CalculateScores()
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &Cvss3xDiff{
+		Old:                     a,
+		New:                     b,
+		Metrics:                 make([]*MetricDiff, 0),
+		OldScores:               oldScores,
+		NewScores:               newScores,
+		BaseScoreDelta:          roundToOneDecimal(newScores.BaseScore - oldScores.BaseScore),
+		TemporalScoreDelta:      roundToOneDecimal(newScores.TemporalScore - oldScores.TemporalScore),
+		EnvironmentalScoreDelta: roundToOneDecimal(newScores.EnvironmentalScore - oldScores.EnvironmentalScore),
+	}
+
+	for _, m := range cvss3xMetrics {
+		oldValue, newValue := m.get(a), m.get(b)
+		if sameVectorValue(oldValue, newValue) {
+			continue
+		}
+		diff.Metrics = append(diff.Metrics, &MetricDiff{
+			Group:     m.group,
+			Field:     m.field,
+			ShortName: m.shortName,
+			Old:       nilIfNilVector(oldValue),
+			New:       nilIfNilVector(newValue),
+			Direction: m.direction(a, b),
+		})
+	}
+	return diff, nil
+}
+
+// direction 判断指标从a变为b的方向，没有设置的时间指标和环境需求指标按照 Not Defined 处理，
+// 没有设置或者为 Not Defined 的修改后的指标按照对应的基础指标处理
+func (x *cvss3xMetric) direction(a, b *Cvss3x) DiffDirection {
+	oldValue, newValue := x.comparable(a), x.comparable(b)
+	if oldValue == nil || newValue == nil {
+		return DiffDirectionUnknown
+	}
+	c, err := vector.Compare(oldValue, newValue)
+	switch {
+	case err != nil:
+		return DiffDirectionUnknown
+	case c < 0:
+		return DiffDirectionMoreSevere
+	case c > 0:
+		return DiffDirectionLessSevere
+	default:
+		return DiffDirectionEquivalent
+	}
+}
+
+// comparable 返回用于比较严重程度的值，无法确定时返回nil
+func (x *cvss3xMetric) comparable(cvss3x *Cvss3x) vector.Vector {
+	v := x.get(cvss3x)
+	switch {
+	case x.group == GroupBase:
+		return nilIfNilVector(v)
+	case x.group == GroupTemporal || x.isRequirement():
+		if vector.IsNil(v) {
+			return x.valueOf('X')
+		}
+		return v
+	case isModifiedValue(v):
+		return v
+	}
+	// 修改后的指标和基础指标的简称只差一个M前缀
+	return nilIfNilVector(findCvss3xMetric(x.shortName[1:]).get(cvss3x))
+}
+
+func (x *cvss3xMetric) isRequirement() bool {
+	return x.shortName == "CR" || x.shortName == "IR" || x.shortName == "AR"
+}
+
+// sameVectorValue 判断两个向量是否是同一个取值，都没有设置也认为相同
+func sameVectorValue(a, b vector.Vector) bool {
+	if vector.IsNil(a) || vector.IsNil(b) {
+		return vector.IsNil(a) == vector.IsNil(b)
+	}
+	return a.GetShortName() == b.GetShortName() && a.GetShortValue() == b.GetShortValue()
+}
+
+func nilIfNilVector(v vector.Vector) vector.Vector {
+	if vector.IsNil(v) {
+		return nil
+	}
+	return v
+}
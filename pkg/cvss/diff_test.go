@@ -0,0 +1,73 @@
+package cvss_test
+
+import (
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, s string) *cvss.Cvss3x {
+	cvss3x, err := parser.NewCvss3xParser(s).Parse()
+	require.NoError(t, err)
+	return cvss3x
+}
+
+// TestDiff 测试比较两个向量
+func TestDiff(t *testing.T) {
+	vendor := mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:X")
+	nvd := mustParse(t, "CVSS:3.1/AV:L/AC:L/PR:L/UI:N/S:C/C:H/I:H/A:H/E:H/RC:U/MAV:L")
+
+	diff, err := cvss.Diff(vendor, nvd)
+	require.NoError(t, err)
+	assert.True(t, diff.Changed())
+
+	expected := []struct {
+		shortName string
+		group     string
+		old       vector.Vector
+		new       vector.Vector
+		direction cvss.DiffDirection
+	}{
+		{"AV", cvss.GroupBase, vector.AttackVectorNetwork, vector.AttackVectorLocal, cvss.DiffDirectionLessSevere},
+		{"PR", cvss.GroupBase, vector.PrivilegesRequiredNone, vector.PrivilegesRequiredLow, cvss.DiffDirectionLessSevere},
+		{"S", cvss.GroupBase, vector.ScopeUnchanged, vector.ScopeChanged, cvss.DiffDirectionMoreSevere},
+		{"E", cvss.GroupTemporal, vector.ExploitCodeMaturityNotDefined, vector.ExploitCodeMaturityHigh, cvss.DiffDirectionEquivalent},
+		{"RC", cvss.GroupTemporal, nil, vector.ReportConfidenceUnknown, cvss.DiffDirectionLessSevere},
+		{"MAV", cvss.GroupEnvironmental, nil, vector.ModifiedAttackVectorLocal, cvss.DiffDirectionLessSevere},
+	}
+	require.Len(t, diff.Metrics, len(expected))
+	for i, e := range expected {
+		m := diff.Metrics[i]
+		assert.Equal(t, e.shortName, m.ShortName)
+		assert.Equal(t, e.group, m.Group)
+		assert.Equal(t, e.old, m.Old)
+		assert.Equal(t, e.new, m.New)
+		assert.Equal(t, e.direction, m.Direction, e.shortName)
+	}
+	assert.Equal(t, "AV: N -> L (less severe)", diff.Metrics[0].String())
+	assert.Equal(t, "RC: (not set) -> U (less severe)", diff.Metrics[4].String())
+
+	assert.Equal(t, 9.8, diff.OldScores.BaseScore)
+	assert.Equal(t, 8.8, diff.NewScores.BaseScore)
+	assert.Equal(t, -1.0, diff.BaseScoreDelta)
+	assert.Equal(t, -1.7, diff.TemporalScoreDelta)
+}
+
+// TestDiff_Unchanged 测试相同的向量
+func TestDiff_Unchanged(t *testing.T) {
+	a := mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+	diff, err := cvss.Diff(a, mustParse(t, a.String()))
+	require.NoError(t, err)
+	assert.False(t, diff.Changed())
+	assert.Equal(t, 0.0, diff.BaseScoreDelta)
+
+	_, err = cvss.Diff(a, nil)
+	assert.ErrorIs(t, err, cvss.ErrDiffNilCvss3x)
+
+	_, err = cvss.Diff(a, mustParse(t, "CVSS:3.1/AV:N"))
+	assert.ErrorIs(t, err, cvss.ErrMetricMissing)
+}
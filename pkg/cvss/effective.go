@@ -0,0 +1,81 @@
+package cvss
+
+import "github.com/scagogogo/cvss-parser/pkg/vector"
+
+// EffectiveMetric 一个基础指标在应用环境指标的修改之后实际生效的值
+type EffectiveMetric struct {
+
+	// 基础指标的字段名，比如 AttackVector
+	Field string
+
+	// 基础指标的简称，比如 AV
+	ShortName string
+
+	// 生效的值，总是基础指标的取值，比如 MAV:L 生效时这里是 AV:L ，基础指标没有设置时为nil
+	Value vector.Vector
+
+	// 是否被修改后的指标覆盖了
+	Modified bool
+}
+
+// EffectiveMetrics 八个基础指标在应用环境指标的修改之后实际生效的值
+type EffectiveMetrics struct {
+	AttackVector       *EffectiveMetric
+	AttackComplexity   *EffectiveMetric
+	PrivilegesRequired *EffectiveMetric
+	UserInteraction    *EffectiveMetric
+	Scope              *EffectiveMetric
+	Confidentiality    *EffectiveMetric
+	Integrity          *EffectiveMetric
+	Availability       *EffectiveMetric
+}
+
+// All 按照向量字符串中的顺序返回所有的指标
+func (x *EffectiveMetrics) All() []*EffectiveMetric {
+	return []*EffectiveMetric{
+		x.AttackVector,
+		x.AttackComplexity,
+		x.PrivilegesRequired,
+		x.UserInteraction,
+		x.Scope,
+		x.Confidentiality,
+		x.Integrity,
+		x.Availability,
+	}
+}
+
+// EffectiveMetrics 计算每个基础指标实际生效的值，修改后的指标设置了并且不是 Not Defined (X) 时覆盖基础指标，
+// 比如 MAV:L 会覆盖 AV:N ，而 MAV:X 或者没有设置 MAV 时仍然使用 AV
+func (x *Cvss3x) EffectiveMetrics() *EffectiveMetrics {
+	effective := func(shortName string) *EffectiveMetric {
+		m := findCvss3xMetric(shortName)
+		value := nilIfNilVector(m.get(x))
+		modified := findCvss3xMetric("M" + shortName).get(x)
+		isModified := isModifiedValue(modified)
+		if isModified {
+			// 修改后的指标的取值和基础指标一一对应，统一返回基础指标的单例
+			value = m.valueOf(modified.GetShortValue())
+		}
+		return &EffectiveMetric{
+			Field:     m.field,
+			ShortName: m.shortName,
+			Value:     value,
+			Modified:  isModified,
+		}
+	}
+	return &EffectiveMetrics{
+		AttackVector:       effective("AV"),
+		AttackComplexity:   effective("AC"),
+		PrivilegesRequired: effective("PR"),
+		UserInteraction:    effective("UI"),
+		Scope:              effective("S"),
+		Confidentiality:    effective("C"),
+		Integrity:          effective("I"),
+		Availability:       effective("A"),
+	}
+}
+
+// isModifiedValue 修改后的指标是否真的修改了基础指标，没有设置或者设置为 Not Defined (X) 时都沿用基础指标
+func isModifiedValue(modified vector.Vector) bool {
+	return !vector.IsNil(modified) && modified.GetShortValue() != 'X'
+}
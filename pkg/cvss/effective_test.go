@@ -0,0 +1,32 @@
+package cvss_test
+
+import (
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCvss3x_EffectiveMetrics 测试修改后的指标覆盖基础指标
+func TestCvss3x_EffectiveMetrics(t *testing.T) {
+	effective := mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/MAV:L/MAC:X/MS:C").EffectiveMetrics()
+
+	assert.Equal(t, vector.AttackVectorLocal, effective.AttackVector.Value)
+	assert.True(t, effective.AttackVector.Modified)
+	assert.Equal(t, "AV", effective.AttackVector.ShortName)
+
+	// MAC:X 沿用基础指标
+	assert.Equal(t, vector.AttackComplexityLow, effective.AttackComplexity.Value)
+	assert.False(t, effective.AttackComplexity.Modified)
+
+	assert.Equal(t, vector.ScopeChanged, effective.Scope.Value)
+	assert.True(t, effective.Scope.Modified)
+
+	// 没有设置修改后的指标
+	assert.Equal(t, vector.ConfidentialityHigh, effective.Confidentiality.Value)
+	assert.False(t, effective.Confidentiality.Modified)
+
+	all := effective.All()
+	assert.Len(t, all, 8)
+	assert.Equal(t, "Availability", all[7].Field)
+}
@@ -0,0 +1,208 @@
+package cvss
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+)
+
+var (
+	_ json.Marshaler   = Cvss3x{}
+	_ json.Unmarshaler = &Cvss3x{}
+)
+
+var (
+	// ErrJSONMissingVectorString JSON中没有 vectorString 字段
+	ErrJSONMissingVectorString = errors.New("cvss json error, vectorString is required")
+
+	// ErrJSONMismatch JSON中的字段和 vectorString 不一致
+	ErrJSONMismatch = errors.New("cvss json error, field does not match vectorString")
+)
+
+// cvss3xJSON FIRST 发布的 cvss-v3.0 和 cvss-v3.1 JSON Schema
+// https://www.first.org/cvss/cvss-v3.1.json
+type cvss3xJSON struct {
+	Version      string `json:"version"`
+	VectorString string `json:"vectorString"`
+
+	AttackVector          string  `json:"attackVector,omitempty"`
+	AttackComplexity      string  `json:"attackComplexity,omitempty"`
+	PrivilegesRequired    string  `json:"privilegesRequired,omitempty"`
+	UserInteraction       string  `json:"userInteraction,omitempty"`
+	Scope                 string  `json:"scope,omitempty"`
+	ConfidentialityImpact string  `json:"confidentialityImpact,omitempty"`
+	IntegrityImpact       string  `json:"integrityImpact,omitempty"`
+	AvailabilityImpact    string  `json:"availabilityImpact,omitempty"`
+	BaseScore             float64 `json:"baseScore"`
+	BaseSeverity          string  `json:"baseSeverity"`
+
+	ExploitCodeMaturity string   `json:"exploitCodeMaturity,omitempty"`
+	RemediationLevel    string   `json:"remediationLevel,omitempty"`
+	ReportConfidence    string   `json:"reportConfidence,omitempty"`
+	TemporalScore       *float64 `json:"temporalScore,omitempty"`
+	TemporalSeverity    string   `json:"temporalSeverity,omitempty"`
+
+	ConfidentialityRequirement    string   `json:"confidentialityRequirement,omitempty"`
+	IntegrityRequirement          string   `json:"integrityRequirement,omitempty"`
+	AvailabilityRequirement       string   `json:"availabilityRequirement,omitempty"`
+	ModifiedAttackVector          string   `json:"modifiedAttackVector,omitempty"`
+	ModifiedAttackComplexity      string   `json:"modifiedAttackComplexity,omitempty"`
+	ModifiedPrivilegesRequired    string   `json:"modifiedPrivilegesRequired,omitempty"`
+	ModifiedUserInteraction       string   `json:"modifiedUserInteraction,omitempty"`
+	ModifiedScope                 string   `json:"modifiedScope,omitempty"`
+	ModifiedConfidentialityImpact string   `json:"modifiedConfidentialityImpact,omitempty"`
+	ModifiedIntegrityImpact       string   `json:"modifiedIntegrityImpact,omitempty"`
+	ModifiedAvailabilityImpact    string   `json:"modifiedAvailabilityImpact,omitempty"`
+	EnvironmentalScore            *float64 `json:"environmentalScore,omitempty"`
+	EnvironmentalSeverity         string   `json:"environmentalSeverity,omitempty"`
+}
+
+// metrics 按照 cvss3xMetric.jsonName 返回每个指标对应的字段
+func (x *cvss3xJSON) metrics() map[string]*string {
+	return map[string]*string{
+		"attackVector":                  &x.AttackVector,
+		"attackComplexity":              &x.AttackComplexity,
+		"privilegesRequired":            &x.PrivilegesRequired,
+		"userInteraction":               &x.UserInteraction,
+		"scope":                         &x.Scope,
+		"confidentialityImpact":         &x.ConfidentialityImpact,
+		"integrityImpact":               &x.IntegrityImpact,
+		"availabilityImpact":            &x.AvailabilityImpact,
+		"exploitCodeMaturity":           &x.ExploitCodeMaturity,
+		"remediationLevel":              &x.RemediationLevel,
+		"reportConfidence":              &x.ReportConfidence,
+		"confidentialityRequirement":    &x.ConfidentialityRequirement,
+		"integrityRequirement":          &x.IntegrityRequirement,
+		"availabilityRequirement":       &x.AvailabilityRequirement,
+		"modifiedAttackVector":          &x.ModifiedAttackVector,
+		"modifiedAttackComplexity":      &x.ModifiedAttackComplexity,
+		"modifiedPrivilegesRequired":    &x.ModifiedPrivilegesRequired,
+		"modifiedUserInteraction":       &x.ModifiedUserInteraction,
+		"modifiedScope":                 &x.ModifiedScope,
+		"modifiedConfidentialityImpact": &x.ModifiedConfidentialityImpact,
+		"modifiedIntegrityImpact":       &x.ModifiedIntegrityImpact,
+		"modifiedAvailabilityImpact":    &x.ModifiedAvailabilityImpact,
+	}
+}
+
+// MarshalJSON 按照 FIRST 的 JSON Schema 输出，评分使用 NewCalculator 计算，
+// 设置了时间指标或者环境指标时才会输出对应的评分。
+// 和 MarshalText 一样使用值接收者，这样结构体中的 Cvss3x 字段不管是不是指针输出的都是对象
+func (x Cvss3x) MarshalJSON() ([]byte, error) {
+	return x.ToJSON(nil)
+}
+
+// ToJSON 按照 FIRST 的 JSON Schema 输出，使用给定的计算器计算评分，calculator 为nil时使用 NewCalculator(x)
+func (x *Cvss3x) ToJSON(calculator *Calculator) ([]byte, error) {
+	if calculator == nil {
+		calculator = NewCalculator(x)
+	}
+	scores, err := calculator.CalculateScores()
+	if err != nil {
+		return nil, err
+	}
+
+	data := &cvss3xJSON{
+		Version:      fmt.Sprintf("%d.%d", x.MajorVersion, x.MinorVersion),
+		VectorString: x.String(),
+		BaseScore:    scores.BaseScore,
+		BaseSeverity: jsonSeverity(scores.BaseSeverity),
+	}
+	fields := data.metrics()
+	for _, m := range cvss3xMetrics {
+		if v := m.get(x); !vector.IsNil(v) {
+			*fields[m.jsonName] = jsonValue(v)
+		}
+	}
+	if x.HasTemporal() {
+		data.TemporalScore = &scores.TemporalScore
+		data.TemporalSeverity = jsonSeverity(scores.TemporalSeverity)
+	}
+	if x.HasEnvironmental() {
+		data.EnvironmentalScore = &scores.EnvironmentalScore
+		data.EnvironmentalSeverity = jsonSeverity(scores.EnvironmentalSeverity)
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON 读取 FIRST 的 JSON Schema ，向量以 vectorString 为准，
+// 其它指标字段存在时必须和 vectorString 一致，version 也必须和 vectorString 的前缀一致，
+// 结果会经过 Check 校验。JSON中的评分会被忽略，需要核对评分时请使用 VerifyDeclaredScore 。
+// 为了方便在配置文件中使用，也接受一个向量字符串，此时和 UnmarshalText 相同
+func (x *Cvss3x) UnmarshalJSON(bytes []byte) error {
+	if text := strings.TrimSpace(string(bytes)); strings.HasPrefix(text, `"`) {
+		var s string
+		if err := json.Unmarshal(bytes, &s); err != nil {
+			return err
+		}
+		return x.UnmarshalText([]byte(s))
+	}
+
+	data := &cvss3xJSON{}
+	if err := json.Unmarshal(bytes, data); err != nil {
+		return err
+	}
+	if data.VectorString == "" {
+		return ErrJSONMissingVectorString
+	}
+
+	cvss3x, err := parseCvss3xString(data.VectorString)
+	if err != nil {
+		return err
+	}
+	if version := fmt.Sprintf("%d.%d", cvss3x.MajorVersion, cvss3x.MinorVersion); data.Version != "" && data.Version != version {
+		return fmt.Errorf("%w: version %s, vectorString %s", ErrJSONMismatch, data.Version, data.VectorString)
+	}
+
+	fields := data.metrics()
+	for _, m := range cvss3xMetrics {
+		value := *fields[m.jsonName]
+		if value == "" {
+			continue
+		}
+		v := m.jsonValueOf(value)
+		if v == nil {
+			return m.newValidationError(value, ErrMetricValue)
+		}
+		current := m.get(cvss3x)
+		switch {
+		case !vector.IsNil(current) && current.GetShortValue() == v.GetShortValue():
+		case vector.IsNil(current) && v.GetShortValue() == 'X':
+			// 向量字符串中省略的指标等价于 Not Defined
+		default:
+			return fmt.Errorf("%w: %s %s, vectorString %s", ErrJSONMismatch, m.jsonName, value, data.VectorString)
+		}
+	}
+
+	if err := cvss3x.Check(); err != nil {
+		return err
+	}
+	*x = *cvss3x
+	return nil
+}
+
+// jsonValue 向量取值在 JSON Schema 中的枚举值，比如 NETWORK、PROOF_OF_CONCEPT
+func jsonValue(v vector.Vector) string {
+	// Schema 中 Adjacent 的枚举值是 ADJACENT_NETWORK
+	if v.GetLongValue() == "Adjacent" {
+		return "ADJACENT_NETWORK"
+	}
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToUpper(v.GetLongValue()))
+}
+
+// jsonValueOf 根据 JSON Schema 中的枚举值查找指标的合法向量，找不到时返回nil
+func (x *cvss3xMetric) jsonValueOf(value string) vector.Vector {
+	for _, v := range x.values {
+		if jsonValue(v) == value {
+			return v
+		}
+	}
+	return nil
+}
+
+func jsonSeverity(severity Severity) string {
+	return strings.ToUpper(string(severity))
+}
@@ -0,0 +1,118 @@
+package cvss_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCvss3x_MarshalJSON 测试按照 FIRST 的 JSON Schema 输出
+func TestCvss3x_MarshalJSON(t *testing.T) {
+	cvss3x := mustParse(t, "CVSS:3.1/AV:A/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:P")
+	bytes, err := json.Marshal(cvss3x)
+	require.NoError(t, err)
+
+	var data map[string]interface{}
+	require.NoError(t, json.Unmarshal(bytes, &data))
+	assert.Equal(t, "3.1", data["version"])
+	assert.Equal(t, "CVSS:3.1/AV:A/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:P", data["vectorString"])
+	assert.Equal(t, "ADJACENT_NETWORK", data["attackVector"])
+	assert.Equal(t, "HIGH", data["confidentialityImpact"])
+	assert.Equal(t, "PROOF_OF_CONCEPT", data["exploitCodeMaturity"])
+	assert.Equal(t, 8.8, data["baseScore"])
+	assert.Equal(t, "HIGH", data["baseSeverity"])
+	assert.Equal(t, 8.3, data["temporalScore"])
+	assert.NotContains(t, data, "environmentalScore")
+
+	toJSON, err := cvss3x.ToJSON(cvss.NewCalculator(cvss3x))
+	require.NoError(t, err)
+	assert.JSONEq(t, string(bytes), string(toJSON))
+}
+
+// TestCvss3x_JSONRoundTrip 测试序列化之后能还原出相同的向量
+func TestCvss3x_JSONRoundTrip(t *testing.T) {
+	for _, s := range []string{
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		"CVSS:3.0/AV:L/AC:H/PR:L/UI:R/S:C/C:L/I:N/A:N",
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/RL:O/RC:C/CR:H/MAV:L/MS:X",
+	} {
+		bytes, err := json.Marshal(mustParse(t, s))
+		require.NoError(t, err)
+
+		cvss3x := &cvss.Cvss3x{}
+		require.NoError(t, json.Unmarshal(bytes, cvss3x), string(bytes))
+		assert.Equal(t, s, cvss3x.String())
+	}
+}
+
+// TestCvss3x_UnmarshalJSON 测试读取NVD等数据源中的JSON
+func TestCvss3x_UnmarshalJSON(t *testing.T) {
+	cvss3x := &cvss.Cvss3x{}
+	err := json.Unmarshal([]byte(`{
+		"version": "3.1",
+		"vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		"attackVector": "NETWORK",
+		"attackComplexity": "LOW",
+		"privilegesRequired": "NONE",
+		"userInteraction": "NONE",
+		"scope": "UNCHANGED",
+		"confidentialityImpact": "HIGH",
+		"integrityImpact": "HIGH",
+		"availabilityImpact": "HIGH",
+		"exploitCodeMaturity": "NOT_DEFINED",
+		"baseScore": 9.8,
+		"baseSeverity": "CRITICAL"
+	}`), cvss3x)
+	require.NoError(t, err)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", cvss3x.String())
+
+	testCases := []struct {
+		name string
+		json string
+		want error
+	}{
+		{"Missing VectorString", `{"version": "3.1"}`, cvss.ErrJSONMissingVectorString},
+		{"Version Mismatch", `{"version": "3.0", "vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}`, cvss.ErrJSONMismatch},
+		{"Field Mismatch", `{"vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "attackVector": "LOCAL"}`, cvss.ErrJSONMismatch},
+		{"Unknown Enum", `{"vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "attackVector": "REMOTE"}`, cvss.ErrMetricValue},
+		{"Missing Base Metric", `{"vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H"}`, cvss.ErrMetricMissing},
+		{"Unknown Metric", `{"vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/ZZ:X"}`, cvss.ErrMetricName},
+		{"Unsupported Version", `{"vectorString": "CVSS:3.9/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}`, cvss.ErrUnsupportedVersion},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := json.Unmarshal([]byte(tc.json), &cvss.Cvss3x{})
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+// TestCvss3x_UnmarshalJSONSameAsText 测试对象中的 vectorString 和字符串形式使用相同的解析规则
+func TestCvss3x_UnmarshalJSONSameAsText(t *testing.T) {
+	vectorString := "cvss:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+	fromObject, fromString := &cvss.Cvss3x{}, &cvss.Cvss3x{}
+	require.NoError(t, json.Unmarshal([]byte(`{"vectorString": "`+vectorString+`"}`), fromObject))
+	require.NoError(t, json.Unmarshal([]byte(`"`+vectorString+`"`), fromString))
+	assert.Equal(t, fromString.String(), fromObject.String())
+
+	// 同一个指标出现多次时两种写法都报错，而不是以最后一个为准
+	vectorString = "CVSS:3.1/AV:L/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/AV:N"
+	err := json.Unmarshal([]byte(`{"vectorString": "`+vectorString+`"}`), &cvss.Cvss3x{})
+	assert.ErrorIs(t, err, cvss.ErrMetricDuplicate)
+	err = json.Unmarshal([]byte(`"`+vectorString+`"`), &cvss.Cvss3x{})
+	assert.ErrorIs(t, err, cvss.ErrMetricDuplicate)
+}
+
+// TestCvss3x_MarshalJSONField 测试结构体中的值字段和指针字段输出相同的对象
+func TestCvss3x_MarshalJSONField(t *testing.T) {
+	cvss3x := mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+	byValue, err := json.Marshal(struct{ V cvss.Cvss3x }{V: *cvss3x})
+	require.NoError(t, err)
+	byPointer, err := json.Marshal(struct{ V *cvss.Cvss3x }{V: cvss3x})
+	require.NoError(t, err)
+	assert.JSONEq(t, string(byPointer), string(byValue))
+	assert.Contains(t, string(byValue), `"vectorString":"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"`)
+}
@@ -0,0 +1,90 @@
+package cvss
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+)
+
+// ErrOverlayNilCvss3x 覆盖的基础向量为nil
+var ErrOverlayNilCvss3x = errors.New("cvss overlay error, base cvss3x is nil")
+
+// OverlayLayer 一层要覆盖到基础向量上的时间指标或者环境指标，比如某个资产的环境配置，
+// 两个组都可以为nil，组中没有设置的指标表示这一层对该指标没有意见
+type OverlayLayer struct {
+
+	// 这一层的来源，会被记录到结果中，比如 "asset:db-01"
+	Source string
+
+	Temporal      *Cvss3xTemporal
+	Environmental *Cvss3xEnvironmental
+}
+
+// OverlayResult 覆盖的结果
+type OverlayResult struct {
+	Cvss3x *Cvss3x
+
+	// 每个设置了的指标的来源，key是指标简称，value是来源
+	Provenance map[string]string
+}
+
+// SourceOf 获取某个指标的来源，没有设置的指标返回空字符串
+func (x *OverlayResult) SourceOf(shortName string) string {
+	return x.Provenance[shortName]
+}
+
+// Overlay 把若干层时间指标和环境指标覆盖到基础向量上，得到一个新的向量，基础向量本身不会被修改。
+// 优先级规则如下：
+//
+//  1. 基础指标总是来自基础向量，覆盖层不能修改基础指标
+//  2. 基础向量中自带的时间指标和环境指标优先级最低
+//  3. 覆盖层按照参数的顺序依次应用，后面的层优先级更高
+//  4. 覆盖层中设置了的指标会替换之前的值，包括显式设置的 Not Defined (X)，没有设置的指标保持之前的值
+//
+// 每个指标最终来自哪里会记录在结果的 Provenance 中，来自基础向量的指标记为 baseSource
+func Overlay(base *Cvss3x, baseSource string, layers ...*OverlayLayer) (*OverlayResult, error) {
+	if base == nil {
+		return nil, ErrOverlayNilCvss3x
+	}
+
+	result := &OverlayResult{
+		Cvss3x:     base.clone(),
+		Provenance: make(map[string]string),
+	}
+	for _, m := range cvss3xMetrics {
+		if !vector.IsNil(m.get(base)) {
+			result.Provenance[m.shortName] = baseSource
+		}
+	}
+
+	for i, layer := range layers {
+		if layer == nil {
+			continue
+		}
+
+		// 覆盖层只包含时间指标和环境指标，借用一个Cvss3x来统一读取
+		layerCvss3x := &Cvss3x{Cvss3xTemporal: layer.Temporal, Cvss3xEnvironmental: layer.Environmental}
+		errs := make(ValidationErrors, 0)
+		if layer.Temporal != nil {
+			errs = append(errs, layerCvss3x.checkGroup(GroupTemporal, false)...)
+		}
+		if layer.Environmental != nil {
+			errs = append(errs, layerCvss3x.checkGroup(GroupEnvironmental, false)...)
+		}
+		if err := errs.err(); err != nil {
+			return nil, fmt.Errorf("cvss overlay error, layer %d (%s) is invalid: %w", i, layer.Source, err)
+		}
+
+		for _, m := range cvss3xMetrics {
+			if m.group == GroupBase {
+				continue
+			}
+			if v := m.get(layerCvss3x); !vector.IsNil(v) {
+				m.set(result.Cvss3x, v)
+				result.Provenance[m.shortName] = layer.Source
+			}
+		}
+	}
+	return result, nil
+}
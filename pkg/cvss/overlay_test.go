@@ -0,0 +1,63 @@
+package cvss_test
+
+import (
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOverlay 测试把资产的环境配置覆盖到厂商的向量上
+func TestOverlay(t *testing.T) {
+	vendor := mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:P/CR:L")
+
+	result, err := cvss.Overlay(vendor, "vendor",
+		&cvss.OverlayLayer{
+			Source: "default-profile",
+			Environmental: &cvss.Cvss3xEnvironmental{
+				ConfidentialityRequirement: vector.ConfidentialityRequirementMedium,
+				AvailabilityRequirement:    vector.AvailabilityRequirementLow,
+			},
+		},
+		&cvss.OverlayLayer{
+			Source:   "threat-intel",
+			Temporal: &cvss.Cvss3xTemporal{ExploitCodeMaturity: vector.ExploitCodeMaturityHigh},
+		},
+		&cvss.OverlayLayer{
+			Source: "asset:db-01",
+			Environmental: &cvss.Cvss3xEnvironmental{
+				ConfidentialityRequirement: vector.ConfidentialityRequirementHigh,
+				ModifiedAttackVector:       vector.ModifiedAttackVectorAdjacent,
+			},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:H/CR:H/AR:L/MAV:A", result.Cvss3x.String())
+	assert.Equal(t, "vendor", result.SourceOf("AV"))
+	assert.Equal(t, "threat-intel", result.SourceOf("E"))
+	assert.Equal(t, "asset:db-01", result.SourceOf("CR"))
+	assert.Equal(t, "default-profile", result.SourceOf("AR"))
+	assert.Equal(t, "asset:db-01", result.SourceOf("MAV"))
+	assert.Equal(t, "", result.SourceOf("RL"))
+
+	// 基础向量没有被修改
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:P/CR:L", vendor.String())
+}
+
+// TestOverlay_Error 测试非法的覆盖
+func TestOverlay_Error(t *testing.T) {
+	_, err := cvss.Overlay(nil, "vendor")
+	assert.ErrorIs(t, err, cvss.ErrOverlayNilCvss3x)
+
+	vendor := mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+	_, err = cvss.Overlay(vendor, "vendor", &cvss.OverlayLayer{
+		Source:        "broken",
+		Environmental: &cvss.Cvss3xEnvironmental{ModifiedScope: vector.ScopeChanged},
+	})
+	assert.ErrorIs(t, err, cvss.ErrMetricName)
+	assert.Contains(t, err.Error(), "broken")
+}
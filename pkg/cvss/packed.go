@@ -0,0 +1,139 @@
+package cvss
+
+import (
+	"fmt"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+)
+
+// PackedCvss3x 把一个CVSS 3.x向量压缩到一个uint64中，是一个不可变的值类型，
+// 可以直接用 == 比较，可以作为map的key，适合在内存中大量保存向量。
+//
+// 每个指标占用固定的几个bit，保存的是该指标合法取值的下标加1，0表示没有设置这个指标，
+// 从低位到高位依次是 cvss3xMetrics 中的各个指标，然后是4个bit的次版本号和1个bit的 VersionInferred 标记，
+// 主版本号固定为3不需要保存
+type PackedCvss3x uint64
+
+// packedMetricBits 每个指标占用的bit数，和 cvss3xMetrics 一一对应，
+// 布局一旦确定就不能再改变，否则之前保存的值会无法还原，所以为后续可能增加的取值预留了空间
+var packedMetricBits = []uint{
+	// AV AC PR UI S C I A
+	3, 2, 2, 2, 2, 2, 2, 2,
+	// E RL RC
+	3, 3, 3,
+	// CR IR AR
+	3, 3, 3,
+	// MAV MAC MPR MUI MS MC MI MA
+	3, 3, 3, 3, 3, 3, 3, 3,
+}
+
+const (
+	packedMinorVersionBits = 4
+	packedMinorVersionMask = 1<<packedMinorVersionBits - 1
+)
+
+// packedMetricOffsets 每个指标在uint64中的起始位置，以及版本号和标记的位置
+var packedMetricOffsets, packedMinorVersionOffset, packedVersionInferredOffset = func() ([]uint, uint, uint) {
+	offsets := make([]uint, len(packedMetricBits))
+	offset := uint(0)
+	for i, bits := range packedMetricBits {
+		offsets[i] = offset
+		offset += bits
+	}
+	return offsets, offset, offset + packedMinorVersionBits
+}()
+
+// Pack 把Cvss3x压缩为 PackedCvss3x ，没有设置的指标会原样保留为没有设置，
+// 版本号必须是 IsSupportedVersion 支持的版本，设置了的指标必须是合法的取值，否则返回 ValidationErrors
+func (x *Cvss3x) Pack() (PackedCvss3x, error) {
+	errs := make(ValidationErrors, 0)
+	errs = append(errs, x.checkVersion()...)
+
+	packed := uint64(x.MinorVersion&packedMinorVersionMask) << packedMinorVersionOffset
+	if x.VersionInferred {
+		packed |= 1 << packedVersionInferredOffset
+	}
+
+	for i, m := range cvss3xMetrics {
+		v := m.get(x)
+		if vector.IsNil(v) {
+			continue
+		}
+		if err := m.check(v, false); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		packed |= uint64(m.indexOf(v.GetShortValue())+1) << packedMetricOffsets[i]
+	}
+
+	if err := errs.err(); err != nil {
+		return 0, err
+	}
+	return PackedCvss3x(packed), nil
+}
+
+// MustPack 和 Pack 相同，但是出错时会panic，用于确定向量合法的场景
+func (x *Cvss3x) MustPack() PackedCvss3x {
+	packed, err := x.Pack()
+	if err != nil {
+		panic(err)
+	}
+	return packed
+}
+
+// Unpack 还原为Cvss3x，和 parser 的解析结果一样三个指标组总是存在，没有设置指标时是空的
+func (x PackedCvss3x) Unpack() *Cvss3x {
+	cvss3x := NewCvss3x()
+	cvss3x.MajorVersion = 3
+	cvss3x.MinorVersion = x.MinorVersion()
+	cvss3x.VersionInferred = x.VersionInferred()
+	for i, m := range cvss3xMetrics {
+		if v := x.metric(i); v != nil {
+			m.set(cvss3x, v)
+		}
+	}
+	return cvss3x
+}
+
+// MajorVersion 主版本号，固定为3
+func (x PackedCvss3x) MajorVersion() int {
+	return 3
+}
+
+// MinorVersion 次版本号
+func (x PackedCvss3x) MinorVersion() int {
+	return int(uint64(x) >> packedMinorVersionOffset & packedMinorVersionMask)
+}
+
+// VersionInferred 版本号是否是推断出来的
+func (x PackedCvss3x) VersionInferred() bool {
+	return uint64(x)>>packedVersionInferredOffset&1 == 1
+}
+
+// Metric 根据指标简称读取指标的值，没有设置或者简称不存在时返回nil，不需要还原整个Cvss3x
+func (x PackedCvss3x) Metric(shortName string) vector.Vector {
+	for i, m := range cvss3xMetrics {
+		if m.shortName == shortName {
+			return x.metric(i)
+		}
+	}
+	return nil
+}
+
+func (x PackedCvss3x) metric(i int) vector.Vector {
+	index := int(uint64(x)>>packedMetricOffsets[i]&(1<<packedMetricBits[i]-1)) - 1
+	if index < 0 || index >= len(cvss3xMetrics[i].values) {
+		return nil
+	}
+	return cvss3xMetrics[i].values[index]
+}
+
+// String 和 Cvss3x.String 的输出相同
+func (x PackedCvss3x) String() string {
+	return x.Unpack().String()
+}
+
+// GoString 方便调试时查看
+func (x PackedCvss3x) GoString() string {
+	return fmt.Sprintf("cvss.PackedCvss3x(%#x /* %s */)", uint64(x), x.String())
+}
@@ -0,0 +1,72 @@
+package cvss_test
+
+import (
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPackedCvss3x_RoundTrip 测试压缩和还原是无损的
+func TestPackedCvss3x_RoundTrip(t *testing.T) {
+	for _, s := range []string{
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		"CVSS:3.0/AV:P/AC:H/PR:H/UI:R/S:C/C:N/I:L/A:N",
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:X/RL:U/RC:C",
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/RL:O/RC:C/CR:H/IR:M/AR:L/MAV:L/MAC:H/MPR:L/MUI:R/MS:C/MC:L/MI:L/MA:L",
+		"CVSS:3.1/AV:N/S:C/MA:N",
+	} {
+		cvss3x, err := parser.NewCvss3xParser(s).Parse()
+		require.NoError(t, err)
+
+		packed, err := cvss3x.Pack()
+		require.NoError(t, err)
+		assert.Equal(t, s, packed.String())
+		assert.Equal(t, s, packed.Unpack().String())
+		assert.Equal(t, cvss3x.MinorVersion, packed.MinorVersion())
+		assert.Equal(t, cvss3x.AttackVector, packed.Metric("AV"))
+		// 还原的结果和 parser 的解析结果完全相同，包括空的指标组
+		assert.Equal(t, cvss3x, packed.Unpack())
+	}
+
+	cvss3x, err := parser.NewCvss3xParser("AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H", parser.WithDefaultVersion(3, 1)).Parse()
+	require.NoError(t, err)
+	packed := cvss3x.MustPack()
+	assert.True(t, packed.VersionInferred())
+	assert.True(t, packed.Unpack().VersionInferred)
+	assert.Nil(t, packed.Metric("E"))
+	assert.Equal(t, &cvss.Cvss3xTemporal{}, packed.Unpack().Cvss3xTemporal)
+}
+
+// TestPackedCvss3x_Equality 测试相同的向量压缩后相等，可以作为map的key
+func TestPackedCvss3x_Equality(t *testing.T) {
+	a, _ := parser.NewCvss3xParser("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H").Parse()
+	b, _ := parser.NewCvss3xParser("CVSS:3.1/AC:L/AV:N/PR:N/UI:N/S:U/C:H/I:H/A:H").Parse()
+	c, _ := parser.NewCvss3xParser("CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H").Parse()
+
+	counter := map[cvss.PackedCvss3x]int{}
+	counter[a.MustPack()]++
+	counter[b.MustPack()]++
+	counter[c.MustPack()]++
+	assert.Equal(t, 2, counter[a.MustPack()])
+	assert.Equal(t, 1, counter[c.MustPack()])
+}
+
+// TestPackedCvss3x_Error 测试无法压缩的向量
+func TestPackedCvss3x_Error(t *testing.T) {
+	_, err := (&cvss.Cvss3x{MajorVersion: 2}).Pack()
+	assert.ErrorIs(t, err, cvss.ErrUnsupportedVersion)
+
+	// 4个bit可以放下3.2到3.15，但是它们不是支持的版本
+	_, err = (&cvss.Cvss3x{MajorVersion: 3, MinorVersion: 2}).Pack()
+	assert.ErrorIs(t, err, cvss.ErrUnsupportedVersion)
+
+	_, err = (&cvss.Cvss3x{
+		Cvss3xBase:   &cvss.Cvss3xBase{AttackVector: vector.ScopeChanged},
+		MajorVersion: 3,
+	}).Pack()
+	assert.ErrorIs(t, err, cvss.ErrMetricType)
+}
@@ -0,0 +1,53 @@
+package cvss
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity 表示CVSS评分对应的严重性等级
+type Severity string
+
+const (
+	SeverityNone     Severity = "None"
+	SeverityLow      Severity = "Low"
+	SeverityMedium   Severity = "Medium"
+	SeverityHigh     Severity = "High"
+	SeverityCritical Severity = "Critical"
+)
+
+// Severities 按照严重程度从低到高列出了所有的严重性等级
+var Severities = []Severity{SeverityNone, SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical}
+
+// SeverityOf 根据规范中的定性评级表把评分映射为严重性等级
+// None 0.0, Low 0.1-3.9, Medium 4.0-6.9, High 7.0-8.9, Critical 9.0-10.0
+func SeverityOf(score float64) Severity {
+	// 评分都是一位小数，先统一精度避免 6.9999 这种浮点误差
+	score = roundToOneDecimal(score)
+	switch {
+	case score <= 0:
+		return SeverityNone
+	case score < 4.0:
+		return SeverityLow
+	case score < 7.0:
+		return SeverityMedium
+	case score < 9.0:
+		return SeverityHigh
+	default:
+		return SeverityCritical
+	}
+}
+
+// ParseSeverity 解析严重性等级，不区分大小写，所以 FIRST JSON 中的 "CRITICAL" 也可以解析
+func ParseSeverity(s string) (Severity, error) {
+	for _, severity := range Severities {
+		if strings.EqualFold(strings.TrimSpace(s), string(severity)) {
+			return severity, nil
+		}
+	}
+	return "", fmt.Errorf("unknown cvss severity %q", s)
+}
+
+func (x Severity) String() string {
+	return string(x)
+}
@@ -0,0 +1,76 @@
+package cvss
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+var (
+	_ sql.Scanner   = &Cvss3x{}
+	_ driver.Valuer = Cvss3x{}
+	_ sql.Scanner   = new(Severity)
+	_ driver.Valuer = SeverityNone
+)
+
+// ErrSQLNullCvss3x 数据库中的值为NULL，可以为NULL的列请扫描到 **Cvss3x 中
+var ErrSQLNullCvss3x = errors.New("cvss sql error, cannot scan NULL into Cvss3x")
+
+// Value 实现 driver.Valuer ，以规范的向量字符串保存到数据库中，向量不合法时返回校验错误。
+// 使用值接收者，这样nil的 *Cvss3x 会被保存为NULL
+func (x Cvss3x) Value() (driver.Value, error) {
+	text, err := x.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan 实现 sql.Scanner ，从数据库中的向量字符串读取，结果会经过 Check 校验
+func (x *Cvss3x) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		return ErrSQLNullCvss3x
+	case string:
+		return x.UnmarshalText([]byte(src))
+	case []byte:
+		return x.UnmarshalText(src)
+	default:
+		return fmt.Errorf("cvss sql error, cannot scan %T into Cvss3x", src)
+	}
+}
+
+// Value 实现 driver.Valuer ，空的严重性等级保存为NULL，比如没有时间评分时的 TemporalSeverity
+func (x Severity) Value() (driver.Value, error) {
+	if x == "" {
+		return nil, nil
+	}
+	severity, err := ParseSeverity(string(x))
+	if err != nil {
+		return nil, err
+	}
+	return string(severity), nil
+}
+
+// Scan 实现 sql.Scanner ，NULL读取为空的严重性等级，其它值不区分大小写
+func (x *Severity) Scan(src interface{}) error {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		*x = ""
+		return nil
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	default:
+		return fmt.Errorf("cvss sql error, cannot scan %T into Severity", src)
+	}
+	severity, err := ParseSeverity(s)
+	if err != nil {
+		return err
+	}
+	*x = severity
+	return nil
+}
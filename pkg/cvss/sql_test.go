@@ -0,0 +1,152 @@
+package cvss_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryDriver 一个只在内存中保存行的假驱动，INSERT 保存参数，SELECT 按照插入顺序返回所有的行
+type memoryDriver struct {
+	mu   sync.Mutex
+	rows [][]driver.Value
+}
+
+func (x *memoryDriver) Open(name string) (driver.Conn, error) {
+	return &memoryConn{driver: x}, nil
+}
+
+type memoryConn struct {
+	driver *memoryDriver
+}
+
+func (x *memoryConn) Prepare(query string) (driver.Stmt, error) {
+	return &memoryStmt{conn: x, query: query}, nil
+}
+
+func (x *memoryConn) Close() error {
+	return nil
+}
+
+func (x *memoryConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type memoryStmt struct {
+	conn  *memoryConn
+	query string
+}
+
+func (x *memoryStmt) Close() error {
+	return nil
+}
+
+func (x *memoryStmt) NumInput() int {
+	return -1
+}
+
+func (x *memoryStmt) Exec(args []driver.Value) (driver.Result, error) {
+	x.conn.driver.mu.Lock()
+	defer x.conn.driver.mu.Unlock()
+	x.conn.driver.rows = append(x.conn.driver.rows, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (x *memoryStmt) Query(args []driver.Value) (driver.Rows, error) {
+	x.conn.driver.mu.Lock()
+	defer x.conn.driver.mu.Unlock()
+	columns := strings.Split(strings.TrimPrefix(x.query, "SELECT "), ", ")
+	return &memoryRows{columns: columns, rows: append([][]driver.Value(nil), x.conn.driver.rows...)}, nil
+}
+
+type memoryRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (x *memoryRows) Columns() []string {
+	return x.columns
+}
+
+func (x *memoryRows) Close() error {
+	return nil
+}
+
+func (x *memoryRows) Next(dest []driver.Value) error {
+	if len(x.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, x.rows[0])
+	x.rows = x.rows[1:]
+	return nil
+}
+
+func openMemoryDB(t *testing.T, name string) (*sql.DB, *memoryDriver) {
+	d := &memoryDriver{}
+	sql.Register(name, d)
+	db, err := sql.Open(name, "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db, d
+}
+
+// TestCvss3x_SQL 测试向量和严重性等级在数据库中的读写
+func TestCvss3x_SQL(t *testing.T) {
+	db, d := openMemoryDB(t, "cvss-memory")
+
+	cvss3x := mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:P")
+	_, err := db.Exec("INSERT", cvss3x, cvss.SeverityCritical, cvss.Severity(""))
+	require.NoError(t, err)
+	// 保存的是规范的向量字符串
+	assert.Equal(t, []driver.Value{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:P", "Critical", nil}, d.rows[0])
+
+	// nil的向量保存为NULL
+	_, err = db.Exec("INSERT", (*cvss.Cvss3x)(nil), []byte("HIGH"), nil)
+	require.NoError(t, err)
+
+	rows, err := db.Query("SELECT vector, base_severity, temporal_severity")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	require.True(t, rows.Next())
+	var scanned cvss.Cvss3x
+	var baseSeverity, temporalSeverity cvss.Severity
+	require.NoError(t, rows.Scan(&scanned, &baseSeverity, &temporalSeverity))
+	assert.Equal(t, cvss3x.String(), scanned.String())
+	assert.Equal(t, cvss.SeverityCritical, baseSeverity)
+	assert.Equal(t, cvss.Severity(""), temporalSeverity)
+
+	require.True(t, rows.Next())
+	var nullable *cvss.Cvss3x
+	require.NoError(t, rows.Scan(&nullable, &baseSeverity, &temporalSeverity))
+	assert.Nil(t, nullable)
+	assert.Equal(t, cvss.SeverityHigh, baseSeverity)
+	require.NoError(t, rows.Err())
+}
+
+// TestCvss3x_SQLInvalid 测试不合法的值
+func TestCvss3x_SQLInvalid(t *testing.T) {
+	db, _ := openMemoryDB(t, "cvss-memory-invalid")
+
+	_, err := db.Exec("INSERT", &cvss.Cvss3x{MajorVersion: 3, MinorVersion: 1})
+	assert.ErrorIs(t, err, cvss.ErrMetricMissing)
+
+	_, err = db.Exec("INSERT", cvss.Severity("Severe"))
+	assert.Error(t, err)
+
+	var cvss3x cvss.Cvss3x
+	assert.ErrorIs(t, cvss3x.Scan(nil), cvss.ErrSQLNullCvss3x)
+	assert.Error(t, cvss3x.Scan(42))
+	assert.Error(t, cvss3x.Scan("CVSS:3.1/AV:N"))
+
+	var severity cvss.Severity
+	assert.Error(t, severity.Scan("Severe"))
+}
@@ -0,0 +1,39 @@
+package cvss
+
+import (
+	"encoding"
+	"flag"
+)
+
+var (
+	_ encoding.TextMarshaler   = Cvss3x{}
+	_ encoding.TextUnmarshaler = &Cvss3x{}
+	_ flag.Value               = &Cvss3x{}
+)
+
+// MarshalText 输出向量字符串，向量不合法时返回校验错误。
+// 使用值接收者，这样Cvss3x也可以作为JSON对象的key
+func (x Cvss3x) MarshalText() ([]byte, error) {
+	if err := x.Check(); err != nil {
+		return nil, err
+	}
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText 解析向量字符串，必须有 CVSS:3.x 前缀并且同一个指标只能出现一次，结果会经过 Check 校验
+func (x *Cvss3x) UnmarshalText(text []byte) error {
+	cvss3x, err := parseCvss3xString(string(text))
+	if err != nil {
+		return err
+	}
+	if err := cvss3x.Check(); err != nil {
+		return err
+	}
+	*x = *cvss3x
+	return nil
+}
+
+// Set 实现 flag.Value ，可以直接作为命令行参数使用，比如 flag.Var(cvss3x, "vector", "CVSS vector")
+func (x *Cvss3x) Set(s string) error {
+	return x.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,59 @@
+package cvss_test
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCvss3x_Text 测试向量字符串的文本编码
+func TestCvss3x_Text(t *testing.T) {
+	cvss3x := &cvss.Cvss3x{}
+	// parser 包已经注册，所以小写的前缀也可以解析
+	require.NoError(t, cvss3x.UnmarshalText([]byte("cvss:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")))
+	text, err := cvss3x.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", string(text))
+
+	assert.Error(t, cvss3x.UnmarshalText([]byte("AV:N")))
+	assert.ErrorIs(t, cvss3x.UnmarshalText([]byte("CVSS:3.1/AV:N")), cvss.ErrMetricMissing)
+	// 失败时不会修改原来的值
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", cvss3x.String())
+
+	_, err = (cvss.Cvss3x{}).MarshalText()
+	assert.Error(t, err)
+}
+
+// TestCvss3x_Flag 测试作为命令行参数使用
+func TestCvss3x_Flag(t *testing.T) {
+	cvss3x := &cvss.Cvss3x{}
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.Var(cvss3x, "vector", "CVSS vector")
+	require.NoError(t, flags.Parse([]string{"-vector", "CVSS:3.0/AV:L/AC:L/PR:L/UI:N/S:U/C:N/I:H/A:H"}))
+	assert.Equal(t, 0, cvss3x.MinorVersion)
+	assert.Equal(t, "CVSS:3.0/AV:L/AC:L/PR:L/UI:N/S:U/C:N/I:H/A:H", cvss3x.String())
+}
+
+// TestCvss3x_TextInJSON 测试在JSON配置中作为字符串字段和map的key使用
+func TestCvss3x_TextInJSON(t *testing.T) {
+	var config struct {
+		Vector    *cvss.Cvss3x           `json:"vector"`
+		Overrides map[cvss.Cvss3x]string `json:"overrides"`
+	}
+	err := json.Unmarshal([]byte(`{
+		"vector": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		"overrides": {"CVSS:3.1/AV:L/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H": "accepted"}
+	}`), &config)
+	require.NoError(t, err)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", config.Vector.String())
+
+	key := mustParse(t, "CVSS:3.1/AV:L/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+	bytes, err := json.Marshal(map[cvss.Cvss3x]string{*key: "accepted"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"CVSS:3.1/AV:L/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H": "accepted"}`, string(bytes))
+	assert.Len(t, config.Overrides, 1)
+}
@@ -0,0 +1,99 @@
+package cvss
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// DeclaredScore 公告中随向量一起发布的评分，没有发布的项保持为空
+type DeclaredScore struct {
+	BaseScore             *float64
+	BaseSeverity          Severity
+	TemporalScore         *float64
+	TemporalSeverity      Severity
+	EnvironmentalScore    *float64
+	EnvironmentalSeverity Severity
+}
+
+// ScoreMismatch 一项声明的评分和计算出的评分不一致
+type ScoreMismatch struct {
+
+	// 不一致的项，使用 FIRST JSON schema 中的字段名，比如 baseScore、baseSeverity
+	Field string
+
+	// 声明的值
+	Declared string
+
+	// 根据向量计算出的值
+	Computed string
+}
+
+func (x *ScoreMismatch) String() string {
+	return fmt.Sprintf("%s declared %s but computed %s", x.Field, x.Declared, x.Computed)
+}
+
+// ScoreVerification 对一个向量声明的评分进行校验的结果
+type ScoreVerification struct {
+	Cvss3x   *Cvss3x
+	Declared *DeclaredScore
+	Computed *Scores
+
+	// 所有不一致的项，为空表示声明的评分和向量一致
+	Mismatches []*ScoreMismatch
+}
+
+// OK 声明的评分是否和向量一致
+func (x *ScoreVerification) OK() bool {
+	return len(x.Mismatches) == 0
+}
+
+// VerifyDeclaredScore 计算向量的评分并和声明的评分进行比较，评分比较到一位小数，严重性等级不区分大小写。
+// 向量本身不合法无法计算评分时返回错误
+func VerifyDeclaredScore(cvss3x *Cvss3x, declared *DeclaredScore) (*ScoreVerification, error) {
+	computed, err := NewCalculator(cvss3x).CalculateScores()
+	if err != nil {
+		return nil, err
+	}
+	if declared == nil {
+		declared = &DeclaredScore{}
+	}
+
+	verification := &ScoreVerification{
+		Cvss3x:     cvss3x,
+		Declared:   declared,
+		Computed:   computed,
+		Mismatches: make([]*ScoreMismatch, 0),
+	}
+	verification.compareScore("baseScore", declared.BaseScore, computed.BaseScore)
+	verification.compareSeverity("baseSeverity", declared.BaseSeverity, computed.BaseSeverity)
+	verification.compareScore("temporalScore", declared.TemporalScore, computed.TemporalScore)
+	verification.compareSeverity("temporalSeverity", declared.TemporalSeverity, computed.TemporalSeverity)
+	verification.compareScore("environmentalScore", declared.EnvironmentalScore, computed.EnvironmentalScore)
+	verification.compareSeverity("environmentalSeverity", declared.EnvironmentalSeverity, computed.EnvironmentalSeverity)
+	return verification, nil
+}
+
+func (x *ScoreVerification) compareScore(field string, declared *float64, computed float64) {
+	if declared == nil || roundToOneDecimal(*declared) == roundToOneDecimal(computed) {
+		return
+	}
+	x.Mismatches = append(x.Mismatches, &ScoreMismatch{
+		Field:    field,
+		Declared: strconv.FormatFloat(*declared, 'f', -1, 64),
+		Computed: strconv.FormatFloat(computed, 'f', 1, 64),
+	})
+}
+
+func (x *ScoreVerification) compareSeverity(field string, declared, computed Severity) {
+	if declared == "" {
+		return
+	}
+	if severity, err := ParseSeverity(string(declared)); err == nil && severity == computed {
+		return
+	}
+	x.Mismatches = append(x.Mismatches, &ScoreMismatch{
+		Field:    field,
+		Declared: string(declared),
+		Computed: string(computed),
+	})
+}
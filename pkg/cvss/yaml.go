@@ -0,0 +1,118 @@
+package cvss
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ yaml.Marshaler   = Cvss3x{}
+	_ yaml.Unmarshaler = &Cvss3x{}
+)
+
+// yamlVersionKey YAML中保存版本号的key
+const yamlVersionKey = "Version"
+
+// MarshalYAML 输出方便人阅读和编辑的形式，每个设置了的指标一行，使用指标和取值的全称，比如：
+//
+//	Version: "3.1"
+//	Attack Vector: Network
+//	Attack Complexity: Low
+//
+// 向量不合法时返回校验错误
+func (x Cvss3x) MarshalYAML() (interface{}, error) {
+	if err := x.Check(); err != nil {
+		return nil, err
+	}
+
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	appendPair := func(key, value string, style yaml.Style) {
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: key},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: value, Style: style},
+		)
+	}
+	// 版本号需要加引号，否则 3.0 会被当成数字读取为 3
+	appendPair(yamlVersionKey, fmt.Sprintf("%d.%d", x.MajorVersion, x.MinorVersion), yaml.DoubleQuotedStyle)
+	for _, m := range cvss3xMetrics {
+		if v := m.get(&x); !vector.IsNil(v) {
+			appendPair(v.GetLongName(), v.GetLongValue(), 0)
+		}
+	}
+	return node, nil
+}
+
+// UnmarshalYAML 读取 MarshalYAML 输出的形式，指标和取值的全称不区分大小写，
+// 也可以直接是一个向量字符串，此时和 UnmarshalText 相同。结果会经过 Check 校验
+func (x *Cvss3x) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		return x.UnmarshalText([]byte(value.Value))
+	case yaml.MappingNode:
+	default:
+		return fmt.Errorf("cvss yaml error at line %d, expected a vector string or a mapping", value.Line)
+	}
+
+	cvss3x := &Cvss3x{Cvss3xBase: &Cvss3xBase{}}
+	hasVersion := false
+	seen := make(map[string]bool)
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		key, val := value.Content[i], value.Content[i+1]
+		if seen[strings.ToLower(key.Value)] {
+			return fmt.Errorf("cvss yaml error at line %d, duplicate key %s", key.Line, key.Value)
+		}
+		seen[strings.ToLower(key.Value)] = true
+
+		if strings.EqualFold(key.Value, yamlVersionKey) {
+			majorVersion, minorVersion, err := ParseVersion(val.Value)
+			if err != nil {
+				return fmt.Errorf("cvss yaml error at line %d, %w", val.Line, err)
+			}
+			cvss3x.MajorVersion, cvss3x.MinorVersion = majorVersion, minorVersion
+			hasVersion = true
+			continue
+		}
+
+		m := findCvss3xMetricByLongName(key.Value)
+		if m == nil {
+			return fmt.Errorf("cvss yaml error at line %d, %w: %s", key.Line, ErrMetricName, key.Value)
+		}
+		v := m.longValueOf(val.Value)
+		if v == nil {
+			return m.newValidationError(val.Value, ErrMetricValue)
+		}
+		m.set(cvss3x, v)
+	}
+	if !hasVersion {
+		return fmt.Errorf("cvss yaml error at line %d, %s is required", value.Line, yamlVersionKey)
+	}
+
+	if err := cvss3x.Check(); err != nil {
+		return err
+	}
+	*x = *cvss3x
+	return nil
+}
+
+// findCvss3xMetricByLongName 根据指标的全称查找指标定义，不区分大小写，找不到时返回nil
+func findCvss3xMetricByLongName(longName string) *cvss3xMetric {
+	for _, m := range cvss3xMetrics {
+		if strings.EqualFold(m.values[0].GetLongName(), strings.TrimSpace(longName)) {
+			return m
+		}
+	}
+	return nil
+}
+
+// longValueOf 根据取值的全称查找指标的合法向量，不区分大小写，找不到时返回nil
+func (x *cvss3xMetric) longValueOf(longValue string) vector.Vector {
+	for _, v := range x.values {
+		if strings.EqualFold(v.GetLongValue(), strings.TrimSpace(longValue)) {
+			return v
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package cvss_test
+
+import (
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// TestCvss3x_MarshalYAML 测试输出使用全称的YAML
+func TestCvss3x_MarshalYAML(t *testing.T) {
+	bytes, err := yaml.Marshal(mustParse(t, "CVSS:3.0/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N/E:P/MAV:X"))
+	require.NoError(t, err)
+	assert.Equal(t, `Version: "3.0"
+Attack Vector: Network
+Attack Complexity: Low
+Privileges Required: None
+User Interaction: Required
+Scope: Changed
+Confidentiality: Low
+Integrity: Low
+Availability: None
+Exploit Code Maturity: Proof-of-Concept
+Modified Attack Vector: Not Defined
+`, string(bytes))
+
+	cvss3x := &cvss.Cvss3x{}
+	require.NoError(t, yaml.Unmarshal(bytes, cvss3x))
+	assert.Equal(t, "CVSS:3.0/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N/E:P/MAV:X", cvss3x.String())
+}
+
+// TestCvss3x_UnmarshalYAML 测试读取人工编辑的风险登记表
+func TestCvss3x_UnmarshalYAML(t *testing.T) {
+	var register struct {
+		Friendly *cvss.Cvss3x `yaml:"friendly"`
+		Compact  *cvss.Cvss3x `yaml:"compact"`
+	}
+	err := yaml.Unmarshal([]byte(`
+friendly:
+  version: "3.1"
+  attack vector: network
+  Attack Complexity: Low
+  Privileges Required: None
+  User Interaction: None
+  Scope: Unchanged
+  Confidentiality: High
+  Integrity: High
+  Availability: High
+compact: CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+`), &register)
+	require.NoError(t, err)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", register.Friendly.String())
+	assert.Equal(t, register.Compact.String(), register.Friendly.String())
+
+	testCases := []struct {
+		name string
+		yaml string
+		want error
+	}{
+		{"Unknown Metric", "Version: \"3.1\"\nAttack Surface: Network", cvss.ErrMetricName},
+		{"Unknown Value", "Version: \"3.1\"\nAttack Vector: Remote", cvss.ErrMetricValue},
+		{"Missing Base Metric", "Version: \"3.1\"\nAttack Vector: Network", cvss.ErrMetricMissing},
+		{"Invalid Compact Vector", "CVSS:3.1/AV:N", cvss.ErrMetricMissing},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, yaml.Unmarshal([]byte(tc.yaml), &cvss.Cvss3x{}), tc.want)
+		})
+	}
+	assert.Error(t, yaml.Unmarshal([]byte("Attack Vector: Network"), &cvss.Cvss3x{}))
+	assert.Error(t, yaml.Unmarshal([]byte("[AV, N]"), &cvss.Cvss3x{}))
+}
@@ -0,0 +1,164 @@
+package cyclonedx
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Document 一个 CycloneDX JSON 格式的 BOM 或者 VEX 文档，只解析 vulnerabilities ，
+// 其它内容会原样保留，重新输出时不会丢失
+type Document struct {
+	Vulnerabilities []*Vulnerability
+
+	fields map[string]json.RawMessage
+}
+
+// Vulnerability 文档中的一个漏洞，只解析编号和评分，其它内容原样保留
+type Vulnerability struct {
+	BOMRef  string
+	ID      string
+	Ratings []*Rating
+
+	fields map[string]json.RawMessage
+}
+
+// Rating 漏洞的一个评分，https://cyclonedx.org/docs/1.5/json/#vulnerabilities_items_ratings ，
+// 其它内容原样保留
+type Rating struct {
+	Source        *Source  `json:"source,omitempty"`
+	Score         *float64 `json:"score,omitempty"`
+	Severity      string   `json:"severity,omitempty"`
+	Method        string   `json:"method,omitempty"`
+	Vector        string   `json:"vector,omitempty"`
+	Justification string   `json:"justification,omitempty"`
+
+	fields map[string]json.RawMessage
+}
+
+// rating 和 Rating 相同但是没有自定义的 JSON 方法，用来处理解析了的字段
+type rating Rating
+
+// ratingKeys Rating 解析了的字段
+var ratingKeys = []string{"source", "score", "severity", "method", "vector", "justification"}
+
+// Source 评分的来源，比如 NVD
+type Source struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// ReadDocument 读取一个 CycloneDX JSON 文档
+func ReadDocument(r io.Reader) (*Document, error) {
+	x := &Document{}
+	if err := json.NewDecoder(r).Decode(x); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// Write 输出为 JSON
+func (x *Document) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(x)
+}
+
+func (x *Document) UnmarshalJSON(bytes []byte) error {
+	if err := json.Unmarshal(bytes, &x.fields); err != nil {
+		return err
+	}
+	x.Vulnerabilities = nil
+	if raw, ok := x.fields["vulnerabilities"]; ok {
+		return json.Unmarshal(raw, &x.Vulnerabilities)
+	}
+	return nil
+}
+
+func (x *Document) MarshalJSON() ([]byte, error) {
+	fields := copyFields(x.fields)
+	if x.Vulnerabilities != nil {
+		if err := setField(fields, "vulnerabilities", x.Vulnerabilities); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(fields)
+}
+
+func (x *Vulnerability) UnmarshalJSON(bytes []byte) error {
+	if err := json.Unmarshal(bytes, &x.fields); err != nil {
+		return err
+	}
+	var known struct {
+		BOMRef  string    `json:"bom-ref"`
+		ID      string    `json:"id"`
+		Ratings []*Rating `json:"ratings"`
+	}
+	if err := json.Unmarshal(bytes, &known); err != nil {
+		return err
+	}
+	x.BOMRef, x.ID, x.Ratings = known.BOMRef, known.ID, known.Ratings
+	return nil
+}
+
+func (x *Vulnerability) MarshalJSON() ([]byte, error) {
+	fields := copyFields(x.fields)
+	for key, value := range map[string]string{"bom-ref": x.BOMRef, "id": x.ID} {
+		if value == "" {
+			delete(fields, key)
+		} else if err := setField(fields, key, value); err != nil {
+			return nil, err
+		}
+	}
+	if len(x.Ratings) == 0 {
+		delete(fields, "ratings")
+	} else if err := setField(fields, "ratings", x.Ratings); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+func (x *Rating) UnmarshalJSON(bytes []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &fields); err != nil {
+		return err
+	}
+	known := rating{}
+	if err := json.Unmarshal(bytes, &known); err != nil {
+		return err
+	}
+	*x = Rating(known)
+	x.fields = fields
+	return nil
+}
+
+func (x *Rating) MarshalJSON() ([]byte, error) {
+	fields := copyFields(x.fields)
+	for _, key := range ratingKeys {
+		delete(fields, key)
+	}
+	raw, err := json.Marshal(rating(*x))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+func copyFields(fields map[string]json.RawMessage) map[string]json.RawMessage {
+	c := make(map[string]json.RawMessage, len(fields))
+	for key, value := range fields {
+		c[key] = value
+	}
+	return c
+}
+
+func setField(fields map[string]json.RawMessage, key string, value interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	fields[key] = raw
+	return nil
+}
@@ -0,0 +1,113 @@
+package cyclonedx
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+)
+
+// 评分方法，这里只处理 CVSS 3.x 的评分
+const (
+	MethodCVSSv2  = "CVSSv2"
+	MethodCVSSv3  = "CVSSv3"
+	MethodCVSSv31 = "CVSSv31"
+	MethodCVSSv4  = "CVSSv4"
+)
+
+// ParsedRating 一个解析了向量的 CVSS 3.x 评分
+type ParsedRating struct {
+	Vulnerability *Vulnerability
+	Rating        *Rating
+	Cvss3x        *cvss.Cvss3x
+}
+
+// ReadRatings 读取文档中所有 CVSS 3.x 评分的向量
+func ReadRatings(r io.Reader) ([]*ParsedRating, error) {
+	document, err := ReadDocument(r)
+	if err != nil {
+		return nil, err
+	}
+	return document.Cvss3xRatings()
+}
+
+// Cvss3xRatings 解析文档中所有方法为 CVSSv3 或 CVSSv31 并且带有向量的评分，其它方法的评分会被忽略。
+// 向量可以没有 CVSS:3.x 前缀，此时根据方法确定版本号，向量不合法时返回的错误中包含漏洞编号
+func (x *Document) Cvss3xRatings() ([]*ParsedRating, error) {
+	ratings := make([]*ParsedRating, 0)
+	for _, vulnerability := range x.Vulnerabilities {
+		for _, rating := range vulnerability.Ratings {
+			if !isCvss3xMethod(rating.Method) || rating.Vector == "" {
+				continue
+			}
+			minorVersion := 1
+			if rating.Method == MethodCVSSv3 {
+				minorVersion = 0
+			}
+			cvss3x, err := parser.NewCvss3xParser(rating.Vector, parser.WithDefaultVersion(3, minorVersion)).Parse()
+			if err == nil {
+				err = cvss3x.Check()
+			}
+			if err != nil {
+				return nil, fmt.Errorf("cyclonedx vulnerability %s rating %s: %w", vulnerability.name(), rating.Vector, err)
+			}
+			ratings = append(ratings, &ParsedRating{
+				Vulnerability: vulnerability,
+				Rating:        rating,
+				Cvss3x:        cvss3x,
+			})
+		}
+	}
+	return ratings, nil
+}
+
+// NewRating 根据向量生成一个评分，评分使用 Calculator.Calculate ，
+// 也就是设置了环境指标时使用环境评分，其次是时间评分，最后是基础评分
+func NewRating(cvss3x *cvss.Cvss3x, source *Source) (*Rating, error) {
+	score, err := cvss.NewCalculator(cvss3x).Calculate()
+	if err != nil {
+		return nil, err
+	}
+	method := MethodCVSSv31
+	if cvss3x.MinorVersion == 0 {
+		method = MethodCVSSv3
+	}
+	return &Rating{
+		Source:   source,
+		Score:    &score,
+		Severity: strings.ToLower(cvss.SeverityOf(score).String()),
+		Method:   method,
+		Vector:   cvss3x.String(),
+	}, nil
+}
+
+// SetRating 设置漏洞的评分，已经有相同来源和方法的评分时替换它，否则追加
+func (x *Vulnerability) SetRating(rating *Rating) {
+	for i, r := range x.Ratings {
+		if r.Method == rating.Method && r.sourceName() == rating.sourceName() {
+			x.Ratings[i] = rating
+			return
+		}
+	}
+	x.Ratings = append(x.Ratings, rating)
+}
+
+func (x *Vulnerability) name() string {
+	if x.ID != "" {
+		return x.ID
+	}
+	return x.BOMRef
+}
+
+func (x *Rating) sourceName() string {
+	if x.Source == nil {
+		return ""
+	}
+	return x.Source.Name
+}
+
+func isCvss3xMethod(method string) bool {
+	return method == MethodCVSSv3 || method == MethodCVSSv31
+}
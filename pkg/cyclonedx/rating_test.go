@@ -0,0 +1,97 @@
+package cyclonedx
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBOM = `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "components": [{"bom-ref": "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", "type": "library", "name": "log4j-core"}],
+  "vulnerabilities": [
+    {
+      "bom-ref": "vuln-1",
+      "id": "CVE-2021-44228",
+      "source": {"name": "NVD"},
+      "ratings": [
+        {"source": {"name": "NVD"}, "score": 10.0, "severity": "critical", "method": "CVSSv31", "vector": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", "x-vendor": {"reviewed": true}},
+        {"source": {"name": "NVD"}, "score": 9.3, "severity": "high", "method": "CVSSv2", "vector": "AV:N/AC:M/Au:N/C:C/I:C/A:C"}
+      ],
+      "affects": [{"ref": "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"}]
+    },
+    {
+      "id": "CVE-2014-0160",
+      "ratings": [
+        {"source": {"name": "vendor"}, "method": "CVSSv3", "vector": "AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"},
+        {"source": {"name": "vendor"}, "score": 5.0, "method": "other"}
+      ]
+    }
+  ]
+}`
+
+// TestReadRatings 测试读取文档中的 CVSS 3.x 评分
+func TestReadRatings(t *testing.T) {
+	ratings, err := ReadRatings(strings.NewReader(testBOM))
+	require.NoError(t, err)
+	require.Len(t, ratings, 2)
+
+	assert.Equal(t, "CVE-2021-44228", ratings[0].Vulnerability.ID)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", ratings[0].Cvss3x.String())
+
+	// 没有前缀的向量根据方法确定版本号
+	assert.Equal(t, "CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N", ratings[1].Cvss3x.String())
+	assert.True(t, ratings[1].Cvss3x.VersionInferred)
+
+	_, err = ReadRatings(strings.NewReader(`{"vulnerabilities": [{"id": "CVE-1", "ratings": [{"method": "CVSSv31", "vector": "CVSS:3.1/AV:N"}]}]}`))
+	assert.ErrorIs(t, err, cvss.ErrMetricMissing)
+	assert.ErrorContains(t, err, "CVE-1")
+}
+
+// TestDocument_Rescore 测试重新评分之后输出，文档中的其它内容保持不变
+func TestDocument_Rescore(t *testing.T) {
+	document, err := ReadDocument(strings.NewReader(testBOM))
+	require.NoError(t, err)
+	ratings, err := document.Cvss3xRatings()
+	require.NoError(t, err)
+
+	rescored, err := cvss.Overlay(ratings[0].Cvss3x, "NVD", &cvss.OverlayLayer{
+		Source:        "internal",
+		Environmental: &cvss.Cvss3xEnvironmental{ModifiedAttackVector: vector.ModifiedAttackVectorLocal},
+	})
+	require.NoError(t, err)
+	rating, err := NewRating(rescored.Cvss3x, &Source{Name: "internal"})
+	require.NoError(t, err)
+	assert.Equal(t, MethodCVSSv31, rating.Method)
+	assert.Equal(t, 9.4, *rating.Score)
+	assert.Equal(t, "critical", rating.Severity)
+
+	ratings[0].Vulnerability.SetRating(rating)
+	// 相同来源和方法的评分会被替换
+	ratings[0].Vulnerability.SetRating(rating)
+
+	buff := &bytes.Buffer{}
+	require.NoError(t, document.Write(buff))
+
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal(buff.Bytes(), &output))
+	assert.Equal(t, "CycloneDX", output["bomFormat"])
+	assert.Len(t, output["components"], 1)
+
+	vulnerability := output["vulnerabilities"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "vuln-1", vulnerability["bom-ref"])
+	assert.Len(t, vulnerability["affects"], 1)
+	outputRatings := vulnerability["ratings"].([]interface{})
+	require.Len(t, outputRatings, 3)
+	// 评分中没有解析的字段也要保留
+	assert.Equal(t, map[string]interface{}{"reviewed": true}, outputRatings[0].(map[string]interface{})["x-vendor"])
+	assert.Equal(t, 10.0, outputRatings[0].(map[string]interface{})["score"])
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H/MAV:L", outputRatings[2].(map[string]interface{})["vector"])
+}
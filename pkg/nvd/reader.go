@@ -0,0 +1,239 @@
+package nvd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+)
+
+// ErrNotNVDFeed 不是 NVD CVE API 2.0 格式的JSON
+var ErrNotNVDFeed = errors.New("nvd error, expected a CVE API 2.0 JSON object")
+
+// 评分的版本，和 metrics 中的字段对应
+const (
+	VersionV2  = "2.0"
+	VersionV30 = "3.0"
+	VersionV31 = "3.1"
+	VersionV40 = "4.0"
+)
+
+// 评分的类型，Primary 是NVD自己的评分，Secondary 是CNA等其它来源的评分
+const (
+	TypePrimary   = "Primary"
+	TypeSecondary = "Secondary"
+)
+
+// CVE 一个CVE和它的所有评分
+type CVE struct {
+	ID      string
+	Metrics []*Metric
+}
+
+// Metric 一个评分，按照 cvssMetricV2、cvssMetricV30、cvssMetricV31、cvssMetricV40 的顺序排列
+type Metric struct {
+	Version      string
+	Source       string
+	Type         string
+	VectorString string
+	BaseScore    *float64
+	BaseSeverity string
+
+	// 只有 3.0 和 3.1 的评分会被解析和重新计算，其它版本为nil
+	Cvss3x       *cvss.Cvss3x
+	Verification *cvss.ScoreVerification
+
+	// 解析或者计算失败的原因，失败不会中断读取。
+	// 2.0 和 4.0 的评分没有经过检查，此时是 cvss.ErrUnsupportedVersion
+	Err error
+}
+
+// Discrepant 重新计算的评分是否和NVD中的评分不一致，没有检查过的评分返回false，
+// 需要区分时请检查 Err 是否是 cvss.ErrUnsupportedVersion
+func (x *Metric) Discrepant() bool {
+	return x.Verification != nil && !x.Verification.OK()
+}
+
+// Reader 流式读取本地下载的 NVD CVE API 2.0 JSON 文件，每次只解码一个CVE，内存占用和文件大小无关
+type Reader struct {
+	decoder *json.Decoder
+	started bool
+	done    bool
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		decoder: json.NewDecoder(r),
+	}
+}
+
+// Walk 读取所有的CVE，fn 返回错误时停止并返回这个错误
+func Walk(r io.Reader, fn func(cve *CVE) error) error {
+	reader := NewReader(r)
+	for {
+		cve, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(cve); err != nil {
+			return err
+		}
+	}
+}
+
+// Next 读取下一个CVE，读取完时返回 io.EOF 。3.x 的评分会被解析并重新计算，
+// 单个评分的问题记录在 Metric.Err 中，为null的评分会被跳过，只有JSON本身不合法或者缺少 cve 时才返回错误
+func (x *Reader) Next() (*CVE, error) {
+	if x.done {
+		return nil, io.EOF
+	}
+	if !x.started {
+		if err := x.seekVulnerabilities(); err != nil {
+			x.done = true
+			return nil, err
+		}
+		x.started = true
+	}
+	if !x.decoder.More() {
+		x.done = true
+		return nil, io.EOF
+	}
+
+	item := &vulnerabilityJSON{}
+	if err := x.decoder.Decode(item); err != nil {
+		x.done = true
+		return nil, err
+	}
+	if item.CVE == nil {
+		return nil, fmt.Errorf("%w: vulnerability has no cve", ErrNotNVDFeed)
+	}
+	return item.CVE.toCVE(), nil
+}
+
+// seekVulnerabilities 跳过 vulnerabilities 之前的字段，停在数组的第一个元素之前
+func (x *Reader) seekVulnerabilities() error {
+	token, err := x.decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != json.Delim('{') {
+		return ErrNotNVDFeed
+	}
+	for x.decoder.More() {
+		token, err := x.decoder.Token()
+		if err != nil {
+			return err
+		}
+		if token == "vulnerabilities" {
+			token, err := x.decoder.Token()
+			if err != nil {
+				return err
+			}
+			if token != json.Delim('[') {
+				return fmt.Errorf("%w: vulnerabilities is not an array", ErrNotNVDFeed)
+			}
+			return nil
+		}
+		// 跳过其它字段的值
+		var skip json.RawMessage
+		if err := x.decoder.Decode(&skip); err != nil {
+			return err
+		}
+	}
+	return io.EOF
+}
+
+type vulnerabilityJSON struct {
+	CVE *cveJSON `json:"cve"`
+}
+
+type cveJSON struct {
+	ID      string `json:"id"`
+	Metrics struct {
+		V2  []*metricJSON `json:"cvssMetricV2"`
+		V30 []*metricJSON `json:"cvssMetricV30"`
+		V31 []*metricJSON `json:"cvssMetricV31"`
+		V40 []*metricJSON `json:"cvssMetricV40"`
+	} `json:"metrics"`
+}
+
+type metricJSON struct {
+	Source   string          `json:"source"`
+	Type     string          `json:"type"`
+	CvssData json.RawMessage `json:"cvssData"`
+
+	// 2.0 的严重性在 cvssData 外面
+	BaseSeverity string `json:"baseSeverity"`
+}
+
+type cvssDataJSON struct {
+	Version      string   `json:"version"`
+	VectorString string   `json:"vectorString"`
+	BaseScore    *float64 `json:"baseScore"`
+	BaseSeverity string   `json:"baseSeverity"`
+}
+
+func (x *cveJSON) toCVE() *CVE {
+	cve := &CVE{
+		ID:      x.ID,
+		Metrics: make([]*Metric, 0),
+	}
+	for _, group := range []struct {
+		version string
+		metrics []*metricJSON
+	}{
+		{VersionV2, x.Metrics.V2},
+		{VersionV30, x.Metrics.V30},
+		{VersionV31, x.Metrics.V31},
+		{VersionV40, x.Metrics.V40},
+	} {
+		for _, m := range group.metrics {
+			if m == nil {
+				continue
+			}
+			cve.Metrics = append(cve.Metrics, m.toMetric(group.version))
+		}
+	}
+	return cve
+}
+
+func (x *metricJSON) toMetric(version string) *Metric {
+	metric := &Metric{
+		Version:      version,
+		Source:       x.Source,
+		Type:         x.Type,
+		BaseSeverity: x.BaseSeverity,
+	}
+	data := &cvssDataJSON{}
+	if err := json.Unmarshal(x.CvssData, data); err != nil {
+		metric.Err = err
+		return metric
+	}
+	metric.VectorString = data.VectorString
+	metric.BaseScore = data.BaseScore
+	if data.BaseSeverity != "" {
+		metric.BaseSeverity = data.BaseSeverity
+	}
+
+	if version != VersionV30 && version != VersionV31 {
+		metric.Err = fmt.Errorf("nvd cvss %s metric is not rescored, %w", version, cvss.ErrUnsupportedVersion)
+		return metric
+	}
+	cvss3x, declared, err := parser.ParseScoredJSON(x.CvssData)
+	if err == nil {
+		err = cvss3x.Check()
+	}
+	if err != nil {
+		metric.Err = err
+		return metric
+	}
+	metric.Cvss3x = cvss3x
+	metric.Verification, metric.Err = cvss.VerifyDeclaredScore(cvss3x, declared)
+	return metric
+}
@@ -0,0 +1,109 @@
+package nvd
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReader 测试流式读取和重新计算评分
+func TestReader(t *testing.T) {
+	file, err := os.Open("testdata/nvdcve-2.0-sample.json")
+	require.NoError(t, err)
+	defer file.Close()
+	reader := NewReader(file)
+
+	cve, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "CVE-2021-44228", cve.ID)
+	require.Len(t, cve.Metrics, 2)
+
+	v2 := cve.Metrics[0]
+	assert.Equal(t, VersionV2, v2.Version)
+	assert.Equal(t, "HIGH", v2.BaseSeverity)
+	assert.Nil(t, v2.Cvss3x)
+	// 2.0 的评分没有检查过，和检查通过区分开
+	assert.ErrorIs(t, v2.Err, cvss.ErrUnsupportedVersion)
+
+	v31 := cve.Metrics[1]
+	assert.Equal(t, VersionV31, v31.Version)
+	assert.Equal(t, "nvd@nist.gov", v31.Source)
+	assert.Equal(t, TypePrimary, v31.Type)
+	assert.Equal(t, 10.0, *v31.BaseScore)
+	require.NotNil(t, v31.Cvss3x)
+	assert.False(t, v31.Discrepant())
+
+	cve, err = reader.Next()
+	require.NoError(t, err)
+	require.Len(t, cve.Metrics, 2)
+	// 声明的 6.5 和重新计算的 5.5 不一致
+	assert.True(t, cve.Metrics[0].Discrepant())
+	assert.Equal(t, TypeSecondary, cve.Metrics[0].Type)
+	assert.Equal(t, VersionV40, cve.Metrics[1].Version)
+	assert.Nil(t, cve.Metrics[1].Verification)
+	assert.ErrorIs(t, cve.Metrics[1].Err, cvss.ErrUnsupportedVersion)
+
+	// 不合法的向量不会中断读取
+	cve, err = reader.Next()
+	require.NoError(t, err)
+	assert.ErrorIs(t, cve.Metrics[0].Err, cvss.ErrMetricMissing)
+
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+// TestWalk 测试遍历所有的CVE
+func TestWalk(t *testing.T) {
+	file, err := os.Open("testdata/nvdcve-2.0-sample.json")
+	require.NoError(t, err)
+	defer file.Close()
+
+	discrepancies := make([]string, 0)
+	err = Walk(file, func(cve *CVE) error {
+		for _, metric := range cve.Metrics {
+			if metric.Discrepant() {
+				discrepancies = append(discrepancies, cve.ID)
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CVE-2099-0001"}, discrepancies)
+
+	stop := errors.New("stop")
+	assert.Equal(t, stop, Walk(strings.NewReader(`{"vulnerabilities": [{"cve": {"id": "CVE-1"}}]}`), func(cve *CVE) error {
+		return stop
+	}))
+}
+
+// TestReader_Invalid 测试不是 NVD 格式的输入
+func TestReader_Invalid(t *testing.T) {
+	_, err := NewReader(strings.NewReader(`[]`)).Next()
+	assert.ErrorIs(t, err, ErrNotNVDFeed)
+
+	_, err = NewReader(strings.NewReader(`{"vulnerabilities": {}}`)).Next()
+	assert.ErrorIs(t, err, ErrNotNVDFeed)
+
+	_, err = NewReader(strings.NewReader(`{"totalResults": 0}`)).Next()
+	assert.Equal(t, io.EOF, err)
+
+	_, err = NewReader(strings.NewReader(`{"vulnerabilities":[{}]}`)).Next()
+	assert.ErrorIs(t, err, ErrNotNVDFeed)
+
+	_, err = NewReader(strings.NewReader(`{"vulnerabilities":[null]}`)).Next()
+	assert.ErrorIs(t, err, ErrNotNVDFeed)
+
+	// 为null的评分被跳过
+	cve, err := NewReader(strings.NewReader(`{"vulnerabilities":[{"cve":{"id":"CVE-1","metrics":{"cvssMetricV31":[null]}}}]}`)).Next()
+	require.NoError(t, err)
+	assert.Equal(t, "CVE-1", cve.ID)
+	assert.Empty(t, cve.Metrics)
+}
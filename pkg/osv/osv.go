@@ -0,0 +1,254 @@
+package osv
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+)
+
+// 严重性的类型，这里只处理 CVSS_V3
+const (
+	SeverityTypeCVSSV2 = "CVSS_V2"
+	SeverityTypeCVSSV3 = "CVSS_V3"
+	SeverityTypeCVSSV4 = "CVSS_V4"
+)
+
+// Record 一条 OSV 记录，只解析和严重性有关的字段，https://ossf.github.io/osv-schema/ ，
+// 其它内容比如 summary、references 会原样保留，修改严重性之后重新输出时不会丢失
+type Record struct {
+	ID       string      `json:"id"`
+	Aliases  []string    `json:"aliases,omitempty"`
+	Severity []*Severity `json:"severity,omitempty"`
+	Affected []*Affected `json:"affected,omitempty"`
+
+	fields map[string]json.RawMessage
+}
+
+// recordJSON 和 Record 相同但是没有自定义的 JSON 方法，用来处理解析了的字段
+type recordJSON Record
+
+// recordKeys Record 解析了的字段
+var recordKeys = []string{"id", "aliases", "severity", "affected"}
+
+// Severity 严重性，score 是对应类型的向量字符串
+type Severity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+// Affected 受影响的包，可以有自己的严重性，没有时使用记录的严重性，其它内容比如 ranges 会原样保留
+type Affected struct {
+	Package  *Package    `json:"package,omitempty"`
+	Severity []*Severity `json:"severity,omitempty"`
+
+	fields map[string]json.RawMessage
+}
+
+// affectedJSON 和 Affected 相同但是没有自定义的 JSON 方法
+type affectedJSON Affected
+
+// affectedKeys Affected 解析了的字段
+var affectedKeys = []string{"package", "severity"}
+
+type Package struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+	Purl      string `json:"purl,omitempty"`
+}
+
+// AffectedCvss3x 一个受影响的包生效的 CVSS 3.x 向量
+type AffectedCvss3x struct {
+	Affected *Affected
+	Cvss3x   *cvss.Cvss3x
+
+	// 向量是否来自受影响的包自己的严重性，为false表示来自记录的严重性
+	FromAffected bool
+}
+
+// ReadRecord 读取一条 OSV JSON 记录
+func ReadRecord(r io.Reader) (*Record, error) {
+	record := &Record{}
+	if err := json.NewDecoder(r).Decode(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// ReadFile 读取一个 OSV JSON 文件
+func ReadFile(path string) (*Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	record, err := ReadRecord(file)
+	if err != nil {
+		return nil, fmt.Errorf("osv file %s: %w", path, err)
+	}
+	return record, nil
+}
+
+// ReadDir 读取目录中所有的 .json 文件，比如解压之后的 OSV 数据导出，按照文件名排序，不会递归读取子目录
+func ReadDir(dir string) ([]*Record, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	records := make([]*Record, 0, len(paths))
+	for _, path := range paths {
+		record, err := ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// Cvss3x 解析记录的 CVSS_V3 严重性，没有时返回nil
+func (x *Record) Cvss3x() (*cvss.Cvss3x, error) {
+	return x.parseCvss3x(x.Severity)
+}
+
+// Cvss3xByAffected 按照受影响的包返回生效的 CVSS 3.x 向量，受影响的包有自己的 CVSS_V3 严重性时使用它，
+// 否则使用记录的 CVSS_V3 严重性，两者都没有的包会被跳过。其它类型的严重性会被忽略
+func (x *Record) Cvss3xByAffected() ([]*AffectedCvss3x, error) {
+	recordCvss3x, err := x.Cvss3x()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*AffectedCvss3x, 0, len(x.Affected))
+	for _, affected := range x.Affected {
+		cvss3x, err := x.parseCvss3x(affected.Severity)
+		if err != nil {
+			return nil, err
+		}
+		fromAffected := cvss3x != nil
+		if cvss3x == nil {
+			cvss3x = recordCvss3x
+		}
+		if cvss3x == nil {
+			continue
+		}
+		result = append(result, &AffectedCvss3x{
+			Affected:     affected,
+			Cvss3x:       cvss3x,
+			FromAffected: fromAffected,
+		})
+	}
+	return result, nil
+}
+
+func (x *Record) parseCvss3x(severities []*Severity) (*cvss.Cvss3x, error) {
+	for _, severity := range severities {
+		if severity.Type != SeverityTypeCVSSV3 {
+			continue
+		}
+		cvss3x, err := parser.NewCvss3xParser(severity.Score).Parse()
+		if err == nil {
+			err = cvss3x.Check()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("osv record %s severity %s: %w", x.ID, severity.Score, err)
+		}
+		return cvss3x, nil
+	}
+	return nil, nil
+}
+
+// NewSeverity 根据向量生成一个 CVSS_V3 类型的严重性，向量不合法时返回校验错误
+func NewSeverity(cvss3x *cvss.Cvss3x) (*Severity, error) {
+	if err := cvss3x.Check(); err != nil {
+		return nil, err
+	}
+	return &Severity{
+		Type:  SeverityTypeCVSSV3,
+		Score: cvss3x.String(),
+	}, nil
+}
+
+// SetCvss3x 设置记录或者受影响的包的严重性时使用，替换其中已有的 CVSS_V3 严重性，其它类型的严重性保持不变
+func SetCvss3x(severities []*Severity, cvss3x *cvss.Cvss3x) ([]*Severity, error) {
+	severity, err := NewSeverity(cvss3x)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Severity, 0, len(severities)+1)
+	for _, s := range severities {
+		if s.Type != SeverityTypeCVSSV3 {
+			result = append(result, s)
+		}
+	}
+	return append(result, severity), nil
+}
+
+func (x *Record) UnmarshalJSON(bytes []byte) error {
+	known := recordJSON{}
+	fields, err := unmarshalFields(bytes, &known)
+	if err != nil {
+		return err
+	}
+	*x = Record(known)
+	x.fields = fields
+	return nil
+}
+
+func (x *Record) MarshalJSON() ([]byte, error) {
+	return marshalFields(x.fields, recordKeys, recordJSON(*x))
+}
+
+func (x *Affected) UnmarshalJSON(bytes []byte) error {
+	known := affectedJSON{}
+	fields, err := unmarshalFields(bytes, &known)
+	if err != nil {
+		return err
+	}
+	*x = Affected(known)
+	x.fields = fields
+	return nil
+}
+
+func (x *Affected) MarshalJSON() ([]byte, error) {
+	return marshalFields(x.fields, affectedKeys, affectedJSON(*x))
+}
+
+// unmarshalFields 把解析了的字段读到 known 中，并返回所有的字段
+func unmarshalFields(bytes []byte, known interface{}) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &fields); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bytes, known); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// marshalFields 用 known 中的值替换 keys 对应的字段，其它字段原样输出
+func marshalFields(fields map[string]json.RawMessage, keys []string, known interface{}) ([]byte, error) {
+	merged := make(map[string]json.RawMessage, len(fields))
+	for key, value := range fields {
+		merged[key] = value
+	}
+	for _, key := range keys {
+		delete(merged, key)
+	}
+	raw, err := json.Marshal(known)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &merged); err != nil {
+		return nil, err
+	}
+	return json.Marshal(merged)
+}
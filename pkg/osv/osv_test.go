@@ -0,0 +1,93 @@
+package osv
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRecord_Cvss3xByAffected 测试按照受影响的包读取向量
+func TestRecord_Cvss3xByAffected(t *testing.T) {
+	record, err := ReadFile("testdata/GHSA-jfh8-c2jp-5v3q.json")
+	require.NoError(t, err)
+
+	cvss3x, err := record.Cvss3x()
+	require.NoError(t, err)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", cvss3x.String())
+
+	affected, err := record.Cvss3xByAffected()
+	require.NoError(t, err)
+	require.Len(t, affected, 2)
+	assert.Equal(t, "org.apache.logging.log4j:log4j-core", affected[0].Affected.Package.Name)
+	assert.False(t, affected[0].FromAffected)
+	assert.Equal(t, cvss3x.String(), affected[0].Cvss3x.String())
+
+	// 受影响的包自己的 CVSS_V3 严重性优先，CVSS_V2 被忽略
+	assert.True(t, affected[1].FromAffected)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:C/C:H/I:H/A:H", affected[1].Cvss3x.String())
+}
+
+// TestReadDir 测试读取解压之后的数据导出
+func TestReadDir(t *testing.T) {
+	records, err := ReadDir("testdata")
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "PYSEC-2024-0001", records[1].ID)
+
+	// 没有任何严重性的记录
+	cvss3x, err := records[1].Cvss3x()
+	assert.NoError(t, err)
+	assert.Nil(t, cvss3x)
+	affected, err := records[1].Cvss3xByAffected()
+	assert.NoError(t, err)
+	assert.Empty(t, affected)
+}
+
+// TestRecord_InvalidSeverity 测试不合法的向量
+func TestRecord_InvalidSeverity(t *testing.T) {
+	record, err := ReadRecord(strings.NewReader(`{"id": "GO-2024-0001", "affected": [{"severity": [{"type": "CVSS_V3", "score": "CVSS:3.1/AV:N"}]}]}`))
+	require.NoError(t, err)
+	_, err = record.Cvss3xByAffected()
+	assert.ErrorIs(t, err, cvss.ErrMetricMissing)
+	assert.ErrorContains(t, err, "GO-2024-0001")
+}
+
+// TestSetCvss3x 测试输出严重性
+func TestSetCvss3x(t *testing.T) {
+	record, err := ReadFile("testdata/GHSA-jfh8-c2jp-5v3q.json")
+	require.NoError(t, err)
+	affected, err := record.Cvss3xByAffected()
+	require.NoError(t, err)
+
+	cvss3x, err := cvss.NewBuilder(3, 1).Set("AV", 'N').Set("AC", 'L').Set("PR", 'N').Set("UI", 'N').
+		Set("S", 'U').Set("C", 'H').Set("I", 'H').Set("A", 'H').Build()
+	require.NoError(t, err)
+	severities, err := SetCvss3x(affected[1].Affected.Severity, cvss3x)
+	require.NoError(t, err)
+
+	bytes, err := json.Marshal(severities)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"type": "CVSS_V2", "score": "AV:N/AC:M/Au:N/C:C/I:C/A:C"},
+		{"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}
+	]`, string(bytes))
+
+	_, err = NewSeverity(&cvss.Cvss3x{MajorVersion: 3, MinorVersion: 1})
+	assert.ErrorIs(t, err, cvss.ErrMetricMissing)
+
+	// 重新输出记录时没有解析的字段也要保留
+	affected[1].Affected.Severity = severities
+	bytes, err = json.Marshal(record)
+	require.NoError(t, err)
+	var output map[string]interface{}
+	require.NoError(t, json.Unmarshal(bytes, &output))
+	assert.Equal(t, "Remote code injection in Log4j", output["summary"])
+	assert.Equal(t, "2024-03-15T05:01:19Z", output["modified"])
+	outputAffected := output["affected"].([]interface{})
+	assert.Len(t, outputAffected[0].(map[string]interface{})["ranges"], 1)
+	assert.Len(t, outputAffected[1].(map[string]interface{})["severity"], 2)
+}
@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+)
+
+var (
+	// ErrCalculatorURLUnsupported 不是 FIRST 或者 NVD 的 CVSS 3.x 计算器链接
+	ErrCalculatorURLUnsupported = errors.New("calculator url error, not a FIRST or NVD cvss 3.x calculator url")
+
+	// ErrCalculatorURLMissingVector 计算器链接中没有向量
+	ErrCalculatorURLMissingVector = errors.New("calculator url error, vector not found")
+)
+
+// ParseCalculatorURL 从 FIRST 或者 NVD 计算器的链接中解析向量，支持的链接格式：
+//
+//	https://www.first.org/cvss/calculator/3.1#CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+//	https://nvd.nist.gov/vuln-metrics/cvss/v3-calculator?vector=AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H&version=3.1
+//
+// 向量没有 CVSS:3.x 前缀时使用链接中的版本号，结果会经过 Check 校验
+func ParseCalculatorURL(rawURL string) (*cvss.Cvss3x, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, err
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+
+	var vector, version string
+	switch {
+	case host == "first.org" && strings.HasPrefix(u.Path, "/cvss/calculator/"):
+		version = strings.TrimPrefix(u.Path, "/cvss/calculator/")
+		vector = u.Fragment
+	case host == "nvd.nist.gov" && u.Path == "/vuln-metrics/cvss/v3-calculator":
+		version = u.Query().Get("version")
+		vector = u.Query().Get("vector")
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrCalculatorURLUnsupported, rawURL)
+	}
+	if vector == "" {
+		return nil, fmt.Errorf("%w: %s", ErrCalculatorURLMissingVector, rawURL)
+	}
+
+	var options []Cvss3xParserOption
+	var majorVersion, minorVersion int
+	if _, err := fmt.Sscanf(version, "%d.%d", &majorVersion, &minorVersion); err == nil {
+		options = append(options, WithDefaultVersion(majorVersion, minorVersion))
+	} else {
+		// NVD 链接可能没有版本号参数
+		options = append(options, WithDefaultVersion(3, 1))
+	}
+	cvss3x, err := NewCvss3xParser(vector, options...).Parse()
+	if err != nil {
+		return nil, err
+	}
+	if err := cvss3x.Check(); err != nil {
+		return nil, err
+	}
+	return cvss3x, nil
+}
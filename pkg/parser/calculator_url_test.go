@@ -0,0 +1,54 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseCalculatorURL 测试从计算器链接中解析向量
+func TestParseCalculatorURL(t *testing.T) {
+	testCases := []struct {
+		url  string
+		want string
+	}{
+		{"https://www.first.org/cvss/calculator/3.1#CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"},
+		{"https://first.org/cvss/calculator/3.0#CVSS:3.0/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N", "CVSS:3.0/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N"},
+		{"https://nvd.nist.gov/vuln-metrics/cvss/v3-calculator?vector=AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H&version=3.0", "CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"},
+		{"https://nvd.nist.gov/vuln-metrics/cvss/v3-calculator?vector=AV%3AN%2FAC%3AL%2FPR%3AN%2FUI%3AN%2FS%3AC%2FC%3AH%2FI%3AH%2FA%3AH", "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"},
+		{"https://nvd.nist.gov/vuln-metrics/cvss/v3-calculator?vector=CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F&version=3.1", "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F"},
+	}
+	for _, tc := range testCases {
+		cvss3x, err := ParseCalculatorURL(tc.url)
+		require.NoError(t, err, tc.url)
+		assert.Equal(t, tc.want, cvss3x.String())
+
+		// 生成的链接可以原样解析回来
+		for _, build := range []func() (string, error){cvss3x.FIRSTCalculatorURL, cvss3x.NVDCalculatorURL} {
+			u, err := build()
+			require.NoError(t, err)
+			parsed, err := ParseCalculatorURL(u)
+			require.NoError(t, err, u)
+			assert.Equal(t, tc.want, parsed.String())
+		}
+	}
+
+	cvss3x, err := ParseCalculatorURL(testCases[0].url)
+	require.NoError(t, err)
+	u, err := cvss3x.NVDCalculatorURL()
+	require.NoError(t, err)
+	assert.Equal(t, "https://nvd.nist.gov/vuln-metrics/cvss/v3-calculator?vector=AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H&version=3.1", u)
+}
+
+// TestParseCalculatorURL_Errors 测试不支持的链接
+func TestParseCalculatorURL_Errors(t *testing.T) {
+	_, err := ParseCalculatorURL("https://example.com/cvss#CVSS:3.1/AV:N")
+	assert.ErrorIs(t, err, ErrCalculatorURLUnsupported)
+
+	_, err = ParseCalculatorURL("https://www.first.org/cvss/calculator/3.1")
+	assert.ErrorIs(t, err, ErrCalculatorURLMissingVector)
+
+	_, err = ParseCalculatorURL("https://www.first.org/cvss/calculator/3.1#CVSS:3.1/AV:N")
+	assert.Error(t, err)
+}
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/scagogogo/cvss-parser/pkg/cvss"
-	"github.com/scagogogo/cvss-parser/pkg/vector"
 )
 
 var (
@@ -25,41 +24,91 @@ type Cvss3xParser struct {
 	cvss3xStr string
 	csvv3x    *cvss.Cvss3x
 
+	// 没有 CVSS:3.x 前缀时使用的默认版本号，为nil表示必须有前缀
+	defaultVersion *[2]int
+
+	// 配置不合法时的错误，Parse 时直接返回
+	optionErr error
+
 	// 解析使用的上下文
 	cvss3xRunes []rune
 	i           int
+
+	// 已经读取过的指标，同一个指标只能出现一次
+	seen map[string]bool
+}
+
+// Cvss3xParserOption 解析器的可选配置
+type Cvss3xParserOption func(x *Cvss3xParser)
+
+// WithDefaultVersion 允许解析没有 CVSS:3.x 前缀的向量，比如 AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H ，
+// 此时使用给定的版本号，并且解析结果的 VersionInferred 会被标记为true。
+// 版本号必须是 cvss.IsSupportedVersion 支持的版本，否则 Parse 返回 cvss.ErrUnsupportedVersion
+func WithDefaultVersion(majorVersion, minorVersion int) Cvss3xParserOption {
+	return func(x *Cvss3xParser) {
+		if !cvss.IsSupportedVersion(majorVersion, minorVersion) {
+			x.optionErr = fmt.Errorf("cvss 3.x parser error, default version %d.%d, %w", majorVersion, minorVersion, cvss.ErrUnsupportedVersion)
+			return
+		}
+		x.defaultVersion = &[2]int{majorVersion, minorVersion}
+	}
 }
 
-func NewCvss3xParser(cvss3xStr string) *Cvss3xParser {
-	return &Cvss3xParser{
+func NewCvss3xParser(cvss3xStr string, options ...Cvss3xParserOption) *Cvss3xParser {
+	x := &Cvss3xParser{
 		cvss3xStr:   cvss3xStr,
 		cvss3xRunes: []rune(cvss3xStr),
 		i:           0,
 	}
+	for _, option := range options {
+		option(x)
+	}
+	return x
 }
 
 func (x *Cvss3xParser) Parse() (*cvss.Cvss3x, error) {
+	if x.optionErr != nil {
+		return nil, x.optionErr
+	}
 	x.csvv3x = cvss.NewCvss3x()
+	x.seen = make(map[string]bool)
 
-	// 读取魔术头CVSS
-	if err := x.readMagicHead(); err != nil {
-		return nil, err
-	}
+	// 没有前缀时，如果配置了默认版本号则直接使用，否则按照原来的逻辑报魔术头错误
+	skipSlash := true
+	if !x.hasMagicHead() && x.defaultVersion != nil {
+		if len(x.cvss3xRunes) == 0 {
+			return nil, fmt.Errorf("cvss3x syntax error, empty vector")
+		}
+		x.csvv3x.MajorVersion = x.defaultVersion[0]
+		x.csvv3x.MinorVersion = x.defaultVersion[1]
+		x.csvv3x.VersionInferred = true
 
-	// 读取版本号
-	if err := x.readVersion(); err != nil {
-		return nil, err
-	}
+		// 第一个向量前面没有 /
+		skipSlash = false
+	} else {
+		// 读取魔术头CVSS
+		if err := x.readMagicHead(); err != nil {
+			return nil, err
+		}
 
-	// 向量以 / 开头，确保当前位置是 /
-	if x.isNotEnd() && x.cvss3xRunes[x.i] != '/' {
-		return nil, fmt.Errorf("cvss3x %s syntax error at %d, expected '/' but got '%c'", x.cvss3xStr, x.i, x.cvss3xRunes[x.i])
+		// 读取版本号
+		if err := x.readVersion(); err != nil {
+			return nil, err
+		}
+
+		// 向量以 / 开头，确保当前位置是 /
+		if x.isNotEnd() && x.cvss3xRunes[x.i] != '/' {
+			return nil, fmt.Errorf("cvss3x %s syntax error at %d, expected '/' but got '%c'", x.cvss3xStr, x.i, x.cvss3xRunes[x.i])
+		}
 	}
 
 	// 每个向量的格式都是 /KEY:VALUE
 	for x.isNotEnd() {
 		// 跳过 /
-		x.i++
+		if skipSlash {
+			x.i++
+		}
+		skipSlash = true
 
 		// 读取键
 		key, err := x.readKey()
@@ -82,14 +131,17 @@ func (x *Cvss3xParser) Parse() (*cvss.Cvss3x, error) {
 	return x.csvv3x, nil
 }
 
-// 读取魔术头，固定的CVSS
-func (x *Cvss3xParser) readMagicHead() error {
+// 判断是否以 "CVSS:" 开头
+func (x *Cvss3xParser) hasMagicHead() bool {
 	if len(x.cvss3xRunes) < 5 { // 最少需要 "CVSS:"
-		return ErrParserMagicHead
+		return false
 	}
+	return strings.ToUpper(string(x.cvss3xRunes[0:4])) == CVSSMagicHead && x.cvss3xRunes[4] == ':'
+}
 
-	// 检查 "CVSS:" 前缀
-	if strings.ToUpper(string(x.cvss3xRunes[0:4])) != CVSSMagicHead || x.cvss3xRunes[4] != ':' {
+// 读取魔术头，固定的CVSS
+func (x *Cvss3xParser) readMagicHead() error {
+	if !x.hasMagicHead() {
 		return ErrParserMagicHead
 	}
 
@@ -200,11 +252,21 @@ func (x *Cvss3xParser) readValue() (string, error) {
 
 // 将向量键值对映射到CVSS结构中
 func (x *Cvss3xParser) mapVectorToStruct(key, value string) error {
-	// 使用工厂方法获取向量对象
-	vectorObj, err := vector.GetVectorByShortName(key, value)
+	// 每个值只能是一个字符
+	valueRunes := []rune(value)
+	if len(valueRunes) != 1 {
+		return fmt.Errorf("cvss3x %s syntax error, vector %s value %s must be a single character, %w", x.cvss3xStr, key, value, cvss.ErrMetricValue)
+	}
+
+	// 从向量注册表中获取向量对象
+	vectorObj, err := DefaultVectorParser.Parse(key, valueRunes[0])
 	if err != nil {
-		return err
+		return fmt.Errorf("cvss3x %s syntax error, %w", x.cvss3xStr, err)
+	}
+	if x.seen[key] {
+		return fmt.Errorf("cvss3x %s syntax error, vector %s appears more than once, %w", x.cvss3xStr, key, cvss.ErrMetricDuplicate)
 	}
+	x.seen[key] = true
 
 	switch key {
 	// Base指标
@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/vector"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCvss3xParser_Parse 测试解析带前缀的向量
+func TestCvss3xParser_Parse(t *testing.T) {
+	cvss3x, err := NewCvss3xParser("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/CR:H").Parse()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, cvss3x.MajorVersion)
+	assert.Equal(t, 1, cvss3x.MinorVersion)
+	assert.False(t, cvss3x.VersionInferred)
+	assert.Equal(t, vector.AttackVectorNetwork, cvss3x.AttackVector)
+	assert.Equal(t, vector.ExploitCodeMaturityFunctional, cvss3x.ExploitCodeMaturity)
+	assert.Equal(t, vector.ConfidentialityRequirementHigh, cvss3x.ConfidentialityRequirement)
+	assert.NoError(t, cvss3x.Check())
+
+	_, err = NewCvss3xParser("CVSS:3.1/AV:NN").Parse()
+	assert.ErrorIs(t, err, cvss.ErrMetricValue)
+}
+
+// TestCvss3xParser_ParseError 测试解析错误和 Builder 使用相同的错误
+func TestCvss3xParser_ParseError(t *testing.T) {
+	_, err := NewCvss3xParser("CVSS:3.1/XX:N").Parse()
+	assert.ErrorIs(t, err, cvss.ErrMetricName)
+	_, buildErr := cvss.NewBuilder(3, 1).Set("XX", 'N').Build()
+	assert.ErrorIs(t, buildErr, cvss.ErrMetricName)
+
+	_, err = NewCvss3xParser("CVSS:3.1/AC:Z").Parse()
+	assert.ErrorIs(t, err, cvss.ErrMetricValue)
+	_, buildErr = cvss.NewBuilder(3, 1).Set("AC", 'Z').Build()
+	assert.ErrorIs(t, buildErr, cvss.ErrMetricValue)
+
+	// 同一个指标出现多次时报错，而不是以最后一个为准
+	_, err = NewCvss3xParser("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/AV:L").Parse()
+	assert.ErrorIs(t, err, cvss.ErrMetricDuplicate)
+}
+
+// TestCvss3xParser_WithDefaultVersion 测试解析没有前缀的向量
+func TestCvss3xParser_WithDefaultVersion(t *testing.T) {
+	prefixLess := "AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+
+	// 默认情况下没有前缀会报错
+	_, err := NewCvss3xParser(prefixLess).Parse()
+	assert.ErrorIs(t, err, ErrParserMagicHead)
+
+	cvss3x, err := NewCvss3xParser(prefixLess, WithDefaultVersion(3, 0)).Parse()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, cvss3x.MajorVersion)
+	assert.Equal(t, 0, cvss3x.MinorVersion)
+	assert.True(t, cvss3x.VersionInferred)
+	assert.Equal(t, vector.AvailabilityHigh, cvss3x.Availability)
+	assert.Equal(t, "CVSS:3.0/"+prefixLess, cvss3x.String())
+
+	// 有前缀时以前缀中的版本号为准
+	cvss3x, err = NewCvss3xParser("CVSS:3.1/"+prefixLess, WithDefaultVersion(3, 0)).Parse()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cvss3x.MinorVersion)
+	assert.False(t, cvss3x.VersionInferred)
+
+	_, err = NewCvss3xParser("", WithDefaultVersion(3, 1)).Parse()
+	assert.Error(t, err)
+
+	_, err = NewCvss3xParser("/AV:N", WithDefaultVersion(3, 1)).Parse()
+	assert.Error(t, err)
+
+	// 不支持的默认版本号在配置时就会被发现，而不是等到 Check
+	_, err = NewCvss3xParser(prefixLess, WithDefaultVersion(4, 0)).Parse()
+	assert.ErrorIs(t, err, cvss.ErrUnsupportedVersion)
+}
@@ -0,0 +1,139 @@
+package parser
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+)
+
+var (
+	// ErrScoredVectorMissingVector 带评分的向量字符串中没有找到向量
+	ErrScoredVectorMissingVector = errors.New("scored vector error, vector string not found")
+
+	// ErrScoredVectorInvalidScore 声明的评分不是0到10之间的数字，比如 NaN、Inf 或者 12.5
+	ErrScoredVectorInvalidScore = errors.New("scored vector error, score must be a number between 0 and 10")
+)
+
+// ParseScoredVector 解析公告中常见的带评分的向量字符串，评分、严重性等级和向量之间以空白分隔，顺序不限，比如：
+//
+//	9.8 CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+//	9.8 (Critical) CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+//	CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H 9.8
+//
+// 声明的评分和严重性等级被认为是基础评分
+func ParseScoredVector(s string, options ...Cvss3xParserOption) (*cvss.Cvss3x, *cvss.DeclaredScore, error) {
+	declared := &cvss.DeclaredScore{}
+	vectorString := ""
+	for _, field := range strings.Fields(s) {
+		token := strings.Trim(field, "()[],;")
+		if token == "" {
+			continue
+		}
+
+		if score, err := strconv.ParseFloat(token, 64); err == nil {
+			// ParseFloat 也接受 NaN、Inf 这些单词，它们不是合法的评分
+			if !isValidScore(score) {
+				return nil, nil, fmt.Errorf("%w: %s", ErrScoredVectorInvalidScore, token)
+			}
+			if declared.BaseScore != nil {
+				return nil, nil, fmt.Errorf("scored vector %s syntax error, more than one score", s)
+			}
+			declared.BaseScore = &score
+			continue
+		}
+
+		if severity, err := cvss.ParseSeverity(token); err == nil {
+			if declared.BaseSeverity != "" {
+				return nil, nil, fmt.Errorf("scored vector %s syntax error, more than one severity", s)
+			}
+			declared.BaseSeverity = severity
+			continue
+		}
+
+		if vectorString != "" {
+			return nil, nil, fmt.Errorf("scored vector %s syntax error, unexpected %s", s, field)
+		}
+		vectorString = token
+	}
+
+	if vectorString == "" {
+		return nil, nil, ErrScoredVectorMissingVector
+	}
+
+	cvss3x, err := NewCvss3xParser(vectorString, options...).Parse()
+	if err != nil {
+		return nil, nil, err
+	}
+	return cvss3x, declared, nil
+}
+
+// VerifyScoredVector 解析带评分的向量字符串，并校验声明的评分是否和向量一致
+func VerifyScoredVector(s string, options ...Cvss3xParserOption) (*cvss.ScoreVerification, error) {
+	cvss3x, declared, err := ParseScoredVector(s, options...)
+	if err != nil {
+		return nil, err
+	}
+	return cvss.VerifyDeclaredScore(cvss3x, declared)
+}
+
+// scoredJSON 同时包含向量和评分的JSON，字段名和 FIRST 的 cvss-v3.x JSON schema 一致
+type scoredJSON struct {
+	VectorString          string   `json:"vectorString"`
+	BaseScore             *float64 `json:"baseScore"`
+	BaseSeverity          string   `json:"baseSeverity"`
+	TemporalScore         *float64 `json:"temporalScore"`
+	TemporalSeverity      string   `json:"temporalSeverity"`
+	EnvironmentalScore    *float64 `json:"environmentalScore"`
+	EnvironmentalSeverity string   `json:"environmentalSeverity"`
+}
+
+// ParseScoredJSON 解析同时包含 vectorString 和评分的JSON对象，比如：
+//
+//	{"vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "baseScore": 9.8, "baseSeverity": "CRITICAL"}
+func ParseScoredJSON(data []byte, options ...Cvss3xParserOption) (*cvss.Cvss3x, *cvss.DeclaredScore, error) {
+	v := &scoredJSON{}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, nil, err
+	}
+	if v.VectorString == "" {
+		return nil, nil, ErrScoredVectorMissingVector
+	}
+
+	for _, score := range []*float64{v.BaseScore, v.TemporalScore, v.EnvironmentalScore} {
+		if score != nil && !isValidScore(*score) {
+			return nil, nil, fmt.Errorf("%w: %v", ErrScoredVectorInvalidScore, *score)
+		}
+	}
+
+	cvss3x, err := NewCvss3xParser(v.VectorString, options...).Parse()
+	if err != nil {
+		return nil, nil, err
+	}
+	return cvss3x, &cvss.DeclaredScore{
+		BaseScore:             v.BaseScore,
+		BaseSeverity:          cvss.Severity(v.BaseSeverity),
+		TemporalScore:         v.TemporalScore,
+		TemporalSeverity:      cvss.Severity(v.TemporalSeverity),
+		EnvironmentalScore:    v.EnvironmentalScore,
+		EnvironmentalSeverity: cvss.Severity(v.EnvironmentalSeverity),
+	}, nil
+}
+
+// isValidScore 评分必须是0到10之间的数字
+func isValidScore(score float64) bool {
+	return !math.IsNaN(score) && score >= 0 && score <= 10
+}
+
+// VerifyScoredJSON 解析同时包含 vectorString 和评分的JSON对象，并校验声明的评分是否和向量一致
+func VerifyScoredJSON(data []byte, options ...Cvss3xParserOption) (*cvss.ScoreVerification, error) {
+	cvss3x, declared, err := ParseScoredJSON(data, options...)
+	if err != nil {
+		return nil, err
+	}
+	return cvss.VerifyDeclaredScore(cvss3x, declared)
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestVerifyScoredVector 测试校验带评分的向量字符串
+func TestVerifyScoredVector(t *testing.T) {
+	for _, s := range []string{
+		"9.8 CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		"9.8 (Critical) CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H 9.8",
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+	} {
+		verification, err := VerifyScoredVector(s)
+		assert.NoError(t, err, s)
+		assert.True(t, verification.OK(), s)
+		assert.Equal(t, 9.8, verification.Computed.BaseScore)
+	}
+
+	verification, err := VerifyScoredVector("7.5 High CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+	assert.NoError(t, err)
+	assert.False(t, verification.OK())
+	assert.Len(t, verification.Mismatches, 2)
+	assert.Equal(t, "baseScore", verification.Mismatches[0].Field)
+	assert.Equal(t, "7.5", verification.Mismatches[0].Declared)
+	assert.Equal(t, "9.8", verification.Mismatches[0].Computed)
+	assert.Equal(t, "baseSeverity", verification.Mismatches[1].Field)
+	assert.Equal(t, "Critical", verification.Mismatches[1].Computed)
+
+	_, err = VerifyScoredVector("9.8 High")
+	assert.ErrorIs(t, err, ErrScoredVectorMissingVector)
+
+	_, err = VerifyScoredVector("9.8 7.5 CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+	assert.Error(t, err)
+
+	for _, score := range []string{"NaN", "Inf", "+Inf", "-Inf", "-0.1", "10.1"} {
+		_, err = VerifyScoredVector(score + " CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+		assert.ErrorIs(t, err, ErrScoredVectorInvalidScore, score)
+	}
+}
+
+// TestVerifyScoredJSON 测试校验同时包含向量和评分的JSON
+func TestVerifyScoredJSON(t *testing.T) {
+	verification, err := VerifyScoredJSON([]byte(`{
+		"version": "3.1",
+		"vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/RL:O/RC:C",
+		"baseScore": 9.8,
+		"baseSeverity": "CRITICAL",
+		"temporalScore": 9.4,
+		"temporalSeverity": "CRITICAL"
+	}`))
+	assert.NoError(t, err)
+	assert.Len(t, verification.Mismatches, 1)
+	assert.Equal(t, "temporalScore", verification.Mismatches[0].Field)
+	assert.Equal(t, "9.1", verification.Mismatches[0].Computed)
+
+	_, err = VerifyScoredJSON([]byte(`{"baseScore": 9.8}`))
+	assert.ErrorIs(t, err, ErrScoredVectorMissingVector)
+
+	// 和 ParseScoredVector 一样拒绝范围之外的评分
+	_, _, err = ParseScoredJSON([]byte(`{"vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "baseScore": 12.5}`))
+	assert.ErrorIs(t, err, ErrScoredVectorInvalidScore)
+	_, _, err = ParseScoredJSON([]byte(`{"vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "baseScore": 9.8, "temporalScore": -1}`))
+	assert.ErrorIs(t, err, ErrScoredVectorInvalidScore)
+}
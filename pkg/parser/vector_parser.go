@@ -2,6 +2,8 @@ package parser
 
 import (
 	"fmt"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
 	"github.com/scagogogo/cvss-parser/pkg/vector"
 )
 
@@ -25,6 +27,7 @@ func NewVectorParser() *VectorParser {
 	x.Add(vector.ModifiedAttackVectorAdjacent)
 	x.Add(vector.ModifiedAttackVectorLocal)
 	x.Add(vector.ModifiedAttackVectorPhysical)
+	x.Add(vector.ModifiedAttackVectorNotDefined)
 
 	// Attack Complexity
 	x.Add(vector.AttackComplexityLow)
@@ -33,6 +36,7 @@ func NewVectorParser() *VectorParser {
 	// 	Modified Attack Complexity
 	x.Add(vector.ModifiedAttackComplexityLow)
 	x.Add(vector.ModifiedAttackComplexityHigh)
+	x.Add(vector.ModifiedAttackComplexityNotDefined)
 
 	// Privileges Required
 	x.Add(vector.PrivilegesRequiredNone)
@@ -43,6 +47,7 @@ func NewVectorParser() *VectorParser {
 	x.Add(vector.ModifiedPrivilegesRequiredNone)
 	x.Add(vector.ModifiedPrivilegesRequiredLow)
 	x.Add(vector.ModifiedPrivilegesRequiredHigh)
+	x.Add(vector.ModifiedPrivilegesRequiredNotDefined)
 
 	// User Interaction (UI)
 	x.Add(vector.UserInteractionNone)
@@ -51,11 +56,17 @@ func NewVectorParser() *VectorParser {
 	// Modified User Interaction (MUI)
 	x.Add(vector.ModifiedUserInteractionNone)
 	x.Add(vector.ModifiedUserInteractionRequired)
+	x.Add(vector.ModifiedUserInteractionNotDefined)
 
 	// Scope (S)
 	x.Add(vector.ScopeUnchanged)
 	x.Add(vector.ScopeChanged)
 
+	// Modified Scope (MS)
+	x.Add(vector.ModifiedScopeUnchanged)
+	x.Add(vector.ModifiedScopeChanged)
+	x.Add(vector.ModifiedScopeNotDefined)
+
 	// Confidentiality (C)
 	x.Add(vector.ConfidentialityHigh)
 	x.Add(vector.ConfidentialityLow)
@@ -65,6 +76,7 @@ func NewVectorParser() *VectorParser {
 	x.Add(vector.ModifiedConfidentialityHigh)
 	x.Add(vector.ModifiedConfidentialityLow)
 	x.Add(vector.ModifiedConfidentialityNone)
+	x.Add(vector.ModifiedConfidentialityNotDefined)
 
 	// Integrity (I)
 	x.Add(vector.IntegrityHigh)
@@ -75,6 +87,7 @@ func NewVectorParser() *VectorParser {
 	x.Add(vector.ModifiedIntegrityHigh)
 	x.Add(vector.ModifiedIntegrityLow)
 	x.Add(vector.ModifiedIntegrityNone)
+	x.Add(vector.ModifiedIntegrityNotDefined)
 
 	// Availability (A)
 	x.Add(vector.AvailabilityHigh)
@@ -85,6 +98,7 @@ func NewVectorParser() *VectorParser {
 	x.Add(vector.ModifiedAvailabilityHigh)
 	x.Add(vector.ModifiedAvailabilityLow)
 	x.Add(vector.ModifiedAvailabilityNone)
+	x.Add(vector.ModifiedAvailabilityNotDefined)
 
 	// Exploit Code Maturity (E)
 	x.Add(vector.ExploitCodeMaturityNotDefined)
@@ -140,11 +154,11 @@ func (x *VectorParser) Add(v vector.Vector) {
 func (x *VectorParser) Parse(vectorName string, vectorValue rune) (vector.Vector, error) {
 	valueMap, exists := x.VectorMap[vectorName]
 	if !exists {
-		return nil, fmt.Errorf("vector name %s does not exist", vectorName)
+		return nil, fmt.Errorf("vector name %s does not exist, %w", vectorName, cvss.ErrMetricName)
 	}
 	v, exists := valueMap[vectorValue]
 	if !exists {
-		return nil, fmt.Errorf("vector value %s does not exist", string(vectorValue))
+		return nil, fmt.Errorf("vector value %s does not exist, %w", string(vectorValue), cvss.ErrMetricValue)
 	}
 	return v, nil
 }
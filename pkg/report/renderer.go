@@ -0,0 +1,102 @@
+package report
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+)
+
+// MarkdownTemplate 默认的 Markdown 模板
+const MarkdownTemplate = `**CVSS {{ .Version }}: {{ score .Score }} ({{ .Severity }})**
+
+` + "`{{ .Vector }}`" + `
+{{ range .Groups }}
+### {{ .Name }} Metrics: {{ score .Score }} ({{ .Severity }})
+
+| Metric | Value | Description |
+| --- | --- | --- |
+{{ range .Metrics }}| {{ .LongName }} ({{ .ShortName }}) | {{ .LongValue }} ({{ .ShortValue }}) | {{ markdown .Description }} |
+{{ end }}{{ end }}`
+
+// HTMLTemplate 默认的 HTML 模板，输出的是一个片段，可以直接嵌入到页面中
+const HTMLTemplate = `<div class="cvss-report">
+<p><strong>CVSS {{ .Version }}: {{ score .Score }} ({{ .Severity }})</strong></p>
+<p><code>{{ html .Vector }}</code></p>
+{{ range .Groups }}<h3>{{ .Name }} Metrics: {{ score .Score }} ({{ .Severity }})</h3>
+<table>
+<thead><tr><th>Metric</th><th>Value</th><th>Description</th></tr></thead>
+<tbody>
+{{ range .Metrics }}<tr><td>{{ html .LongName }} ({{ html .ShortName }})</td><td>{{ html .LongValue }} ({{ html .ShortValue }})</td><td>{{ html .Description }}</td></tr>
+{{ end }}</tbody>
+</table>
+{{ end }}</div>
+`
+
+// Funcs 模板中可以使用的函数，除了 text/template 内置的函数之外：
+//
+//	score    把评分格式化为一位小数
+//	markdown 转义 Markdown 表格中的竖线并把换行替换为空格
+var Funcs = template.FuncMap{
+	"score": func(score float64) string {
+		return strconv.FormatFloat(score, 'f', 1, 64)
+	},
+	"markdown": func(s string) string {
+		s = strings.ReplaceAll(s, "|", `\|`)
+		return strings.Join(strings.Fields(s), " ")
+	},
+}
+
+// Renderer 使用 text/template 模板渲染报告，模板的数据是 *Report
+type Renderer struct {
+	template *template.Template
+}
+
+// NewRenderer 使用自定义的模板，模板中可以使用 Funcs 中的函数
+func NewRenderer(text string) (*Renderer, error) {
+	t, err := template.New("report").Funcs(Funcs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	return &Renderer{template: t}, nil
+}
+
+// NewMarkdownRenderer 使用默认的 Markdown 模板
+func NewMarkdownRenderer() *Renderer {
+	return MustNewRenderer(MarkdownTemplate)
+}
+
+// NewHTMLRenderer 使用默认的 HTML 模板
+func NewHTMLRenderer() *Renderer {
+	return MustNewRenderer(HTMLTemplate)
+}
+
+// MustNewRenderer 和 NewRenderer 相同，模板不合法时panic，用于初始化包级别的变量
+func MustNewRenderer(text string) *Renderer {
+	renderer, err := NewRenderer(text)
+	if err != nil {
+		panic(err)
+	}
+	return renderer
+}
+
+// Render 渲染向量的报告，向量不合法时返回校验错误
+func (x *Renderer) Render(w io.Writer, cvss3x *cvss.Cvss3x) error {
+	report, err := NewReport(cvss3x)
+	if err != nil {
+		return err
+	}
+	return x.template.Execute(w, report)
+}
+
+// RenderString 渲染向量的报告并返回字符串
+func (x *Renderer) RenderString(cvss3x *cvss.Cvss3x) (string, error) {
+	buff := &bytes.Buffer{}
+	if err := x.Render(buff, cvss3x); err != nil {
+		return "", err
+	}
+	return buff.String(), nil
+}
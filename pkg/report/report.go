@@ -0,0 +1,93 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+)
+
+// Report 渲染模板时使用的数据
+type Report struct {
+	Cvss3x *cvss.Cvss3x
+
+	// 版本号，比如 3.1
+	Version string
+
+	// 规范的向量字符串
+	Vector string
+
+	Scores *cvss.Scores
+
+	// 最终的评分和严重性等级，和 Calculator.Calculate 一致
+	Score    float64
+	Severity cvss.Severity
+
+	// 按照 Base、Temporal、Environmental 的顺序排列，没有设置任何指标的组不会出现
+	Groups []*Group
+}
+
+// Group 一个指标组以及这个组对应的评分
+type Group struct {
+
+	// cvss.GroupBase、cvss.GroupTemporal 或 cvss.GroupEnvironmental
+	Name string
+
+	Score    float64
+	Severity cvss.Severity
+	Metrics  []*Metric
+}
+
+// Metric 一个设置了的指标
+type Metric struct {
+	ShortName   string
+	LongName    string
+	ShortValue  string
+	LongValue   string
+	Description string
+}
+
+// NewReport 计算评分并整理出渲染需要的数据，向量不合法时返回校验错误
+func NewReport(cvss3x *cvss.Cvss3x) (*Report, error) {
+	calculator := cvss.NewCalculator(cvss3x)
+	scores, err := calculator.CalculateScores()
+	if err != nil {
+		return nil, err
+	}
+	score, err := calculator.Calculate()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Cvss3x:   cvss3x,
+		Version:  fmt.Sprintf("%d.%d", cvss3x.MajorVersion, cvss3x.MinorVersion),
+		Vector:   cvss3x.String(),
+		Scores:   scores,
+		Score:    score,
+		Severity: cvss.SeverityOf(score),
+		Groups:   make([]*Group, 0, 3),
+	}
+	for _, group := range []*Group{
+		{Name: cvss.GroupBase, Score: scores.BaseScore, Severity: scores.BaseSeverity},
+		{Name: cvss.GroupTemporal, Score: scores.TemporalScore, Severity: scores.TemporalSeverity},
+		{Name: cvss.GroupEnvironmental, Score: scores.EnvironmentalScore, Severity: scores.EnvironmentalSeverity},
+	} {
+		for _, shortName := range cvss.MetricShortNames() {
+			v := cvss3x.Metric(shortName)
+			if v == nil || cvss.MetricGroup(shortName) != group.Name {
+				continue
+			}
+			group.Metrics = append(group.Metrics, &Metric{
+				ShortName:   v.GetShortName(),
+				LongName:    v.GetLongName(),
+				ShortValue:  string(v.GetShortValue()),
+				LongValue:   v.GetLongValue(),
+				Description: v.GetDescription(),
+			})
+		}
+		if len(group.Metrics) != 0 {
+			report.Groups = append(report.Groups, group)
+		}
+	}
+	return report, nil
+}
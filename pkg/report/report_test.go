@@ -0,0 +1,77 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, s string) *cvss.Cvss3x {
+	cvss3x, err := parser.NewCvss3xParser(s).Parse()
+	require.NoError(t, err)
+	return cvss3x
+}
+
+// TestNewReport 测试按照指标组整理数据
+func TestNewReport(t *testing.T) {
+	report, err := NewReport(mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/MAV:L"))
+	require.NoError(t, err)
+	assert.Equal(t, "3.1", report.Version)
+	assert.Equal(t, 8.4, report.Score)
+	assert.Equal(t, cvss.SeverityHigh, report.Severity)
+
+	// 没有时间指标
+	require.Len(t, report.Groups, 2)
+	assert.Equal(t, cvss.GroupBase, report.Groups[0].Name)
+	assert.Len(t, report.Groups[0].Metrics, 8)
+	assert.Equal(t, "Attack Vector", report.Groups[0].Metrics[0].LongName)
+	assert.Equal(t, "Network", report.Groups[0].Metrics[0].LongValue)
+	assert.NotEmpty(t, report.Groups[0].Metrics[0].Description)
+
+	assert.Equal(t, cvss.GroupEnvironmental, report.Groups[1].Name)
+	assert.Equal(t, 8.4, report.Groups[1].Score)
+	assert.Equal(t, "MAV", report.Groups[1].Metrics[0].ShortName)
+
+	_, err = NewReport(mustParse(t, "CVSS:3.1/AV:N"))
+	assert.ErrorIs(t, err, cvss.ErrMetricMissing)
+}
+
+// TestRenderer 测试默认的模板
+func TestRenderer(t *testing.T) {
+	cvss3x := mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:P")
+
+	markdown, err := NewMarkdownRenderer().RenderString(cvss3x)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(markdown, "**CVSS 3.1: 9.3 (Critical)**\n\n`CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:P`\n"))
+	assert.Contains(t, markdown, "### Base Metrics: 9.8 (Critical)\n\n| Metric | Value | Description |\n| --- | --- | --- |\n| Attack Vector (AV) | Network (N) | ")
+	assert.Contains(t, markdown, "### Temporal Metrics: 9.3 (Critical)")
+	assert.Contains(t, markdown, "| Exploit Code Maturity (E) | Proof-of-Concept (P) | ")
+	assert.NotContains(t, markdown, "Environmental")
+	for _, line := range strings.Split(markdown, "\n") {
+		if strings.HasPrefix(line, "| ") {
+			assert.Equal(t, 4, strings.Count(line, " | ")+2, line)
+		}
+	}
+
+	html, err := NewHTMLRenderer().RenderString(cvss3x)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(html, `<div class="cvss-report">`))
+	assert.Contains(t, html, "<h3>Base Metrics: 9.8 (Critical)</h3>")
+	assert.Contains(t, html, "<tr><td>Attack Vector (AV)</td><td>Network (N)</td><td>")
+}
+
+// TestNewRenderer 测试自定义模板
+func TestNewRenderer(t *testing.T) {
+	renderer, err := NewRenderer(`{{ .Vector }} {{ score .Scores.BaseScore }}{{ range .Groups }} {{ .Name }}={{ len .Metrics }}{{ end }}`)
+	require.NoError(t, err)
+	s, err := renderer.RenderString(mustParse(t, "CVSS:3.0/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N/CR:H"))
+	require.NoError(t, err)
+	assert.Equal(t, "CVSS:3.0/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N/CR:H 5.5 Base=8 Environmental=1", s)
+
+	_, err = NewRenderer("{{ .Vector")
+	assert.Error(t, err)
+}
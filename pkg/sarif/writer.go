@@ -0,0 +1,200 @@
+package sarif
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+)
+
+var (
+	// ErrFindingMissingID 发现的漏洞没有编号
+	ErrFindingMissingID = errors.New("sarif error, finding id is required")
+
+	// ErrNilFinding 发现的列表中有nil
+	ErrNilFinding = errors.New("sarif error, finding is nil")
+)
+
+// Finding 一个带有CVSS向量的漏洞发现
+type Finding struct {
+
+	// 漏洞编号，比如 CVE-2021-44228 ，会作为 SARIF 中的 ruleId
+	ID string
+
+	// 描述，为空时使用编号和评分生成
+	Message string
+
+	// 发现漏洞的位置，比如依赖清单文件，可以为nil
+	Location *FindingLocation
+
+	Cvss3x *cvss.Cvss3x
+}
+
+// FindingLocation 发现漏洞的文件以及行列号，行列号从1开始，为0表示不确定
+type FindingLocation struct {
+	URI         string
+	StartLine   int
+	StartColumn int
+}
+
+// WriterOption 输出的可选配置
+type WriterOption func(x *Writer)
+
+// WithTool 设置 SARIF 中的工具名称和版本，默认为 cvss-parser
+func WithTool(name, version string) WriterOption {
+	return func(x *Writer) {
+		x.toolName, x.toolVersion = name, version
+	}
+}
+
+// WithInformationURI 设置工具的主页
+func WithInformationURI(uri string) WriterOption {
+	return func(x *Writer) {
+		x.informationURI = uri
+	}
+}
+
+// Writer 把漏洞发现输出为 SARIF 2.1.0 日志
+type Writer struct {
+	w io.Writer
+
+	toolName       string
+	toolVersion    string
+	informationURI string
+}
+
+func NewWriter(w io.Writer, options ...WriterOption) *Writer {
+	x := &Writer{
+		w:        w,
+		toolName: "cvss-parser",
+	}
+	for _, option := range options {
+		option(x)
+	}
+	return x
+}
+
+// Write 把所有的发现作为一个run输出为一个完整的 SARIF 日志
+func (x *Writer) Write(findings []*Finding) error {
+	log, err := x.Log(findings)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(x.w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(log)
+}
+
+// Log 把发现转换为 SARIF 日志，不输出，方便调用方在输出前补充其它信息。
+//
+// 每个不同的编号对应一条规则，规则的 security-severity 属性是这个编号所有发现中最高的评分，
+// 代码扫描平台会根据它显示严重程度。每个发现对应一个结果，结果的级别根据严重性等级映射：
+// Critical 和 High 为 error ，Medium 为 warning ，Low 为 note ，None 为 none 。
+// 评分使用 Calculator.Calculate ，也就是设置了环境指标时使用环境评分，其次是时间评分，最后是基础评分
+func (x *Writer) Log(findings []*Finding) (*Log, error) {
+	driver := &Driver{
+		Name:           x.toolName,
+		Version:        x.toolVersion,
+		InformationURI: x.informationURI,
+		Rules:          make([]*Rule, 0),
+	}
+	run := &Run{
+		Tool:    &Tool{Driver: driver},
+		Results: make([]*Result, 0, len(findings)),
+	}
+
+	ruleIndexes := make(map[string]int)
+	ruleScores := make([]float64, 0)
+	for i, finding := range findings {
+		if finding == nil {
+			return nil, fmt.Errorf("finding %d: %w", i, ErrNilFinding)
+		}
+		if finding.ID == "" {
+			return nil, fmt.Errorf("finding %d: %w", i, ErrFindingMissingID)
+		}
+		if finding.Cvss3x == nil {
+			return nil, fmt.Errorf("finding %d (%s): %w", i, finding.ID, cvss.ErrCalculatorNilCvss3x)
+		}
+		score, err := cvss.NewCalculator(finding.Cvss3x).Calculate()
+		if err != nil {
+			return nil, fmt.Errorf("finding %d (%s): %w", i, finding.ID, err)
+		}
+		severity := cvss.SeverityOf(score)
+
+		ruleIndex, ok := ruleIndexes[finding.ID]
+		if !ok {
+			ruleIndex = len(driver.Rules)
+			ruleIndexes[finding.ID] = ruleIndex
+			driver.Rules = append(driver.Rules, &Rule{
+				ID:               finding.ID,
+				ShortDescription: &Message{Text: finding.ID},
+				Properties:       map[string]interface{}{"tags": []string{"security"}},
+			})
+			ruleScores = append(ruleScores, score)
+		} else if score > ruleScores[ruleIndex] {
+			ruleScores[ruleIndex] = score
+		}
+
+		message := finding.Message
+		if message == "" {
+			message = fmt.Sprintf("%s: CVSS %s (%s)", finding.ID, formatScore(score), severity)
+		}
+		result := &Result{
+			RuleID:    finding.ID,
+			RuleIndex: ruleIndex,
+			Level:     LevelOf(severity),
+			Message:   &Message{Text: message},
+			Properties: map[string]interface{}{
+				"security-severity": formatScore(score),
+				"cvssVector":        finding.Cvss3x.String(),
+				"cvssSeverity":      severity.String(),
+			},
+		}
+		if finding.Location != nil {
+			result.Locations = []*Location{newLocation(finding.Location)}
+		}
+		run.Results = append(run.Results, result)
+	}
+
+	for i, rule := range driver.Rules {
+		// security-severity 按照规范约定是一个字符串
+		rule.Properties["security-severity"] = formatScore(ruleScores[i])
+	}
+
+	return &Log{
+		Schema:  Schema,
+		Version: Version,
+		Runs:    []*Run{run},
+	}, nil
+}
+
+// LevelOf 严重性等级对应的 SARIF 结果级别
+func LevelOf(severity cvss.Severity) string {
+	switch severity {
+	case cvss.SeverityCritical, cvss.SeverityHigh:
+		return LevelError
+	case cvss.SeverityMedium:
+		return LevelWarning
+	case cvss.SeverityLow:
+		return LevelNote
+	default:
+		return LevelNone
+	}
+}
+
+func newLocation(location *FindingLocation) *Location {
+	physical := &PhysicalLocation{
+		ArtifactLocation: &ArtifactLocation{URI: location.URI},
+	}
+	if location.StartLine > 0 {
+		physical.Region = &Region{StartLine: location.StartLine, StartColumn: location.StartColumn}
+	}
+	return &Location{PhysicalLocation: physical}
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', 1, 64)
+}
@@ -0,0 +1,89 @@
+package sarif
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/scagogogo/cvss-parser/pkg/cvss"
+	"github.com/scagogogo/cvss-parser/pkg/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, s string) *cvss.Cvss3x {
+	cvss3x, err := parser.NewCvss3xParser(s).Parse()
+	require.NoError(t, err)
+	return cvss3x
+}
+
+// TestWriter 测试输出 SARIF 日志
+func TestWriter(t *testing.T) {
+	findings := []*Finding{
+		{
+			ID:       "CVE-2021-44228",
+			Location: &FindingLocation{URI: "pom.xml", StartLine: 42},
+			Cvss3x:   mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"),
+		},
+		{
+			ID:      "CVE-2021-44228",
+			Message: "log4j-core in a test dependency",
+			Cvss3x:  mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H/MAV:L"),
+		},
+		{
+			ID:     "CVE-2020-0001",
+			Cvss3x: mustParse(t, "CVSS:3.1/AV:L/AC:H/PR:H/UI:R/S:U/C:L/I:N/A:N"),
+		},
+	}
+
+	buff := &bytes.Buffer{}
+	require.NoError(t, NewWriter(buff, WithTool("scanner", "1.0.0")).Write(findings))
+
+	log := &Log{}
+	require.NoError(t, json.Unmarshal(buff.Bytes(), log))
+	assert.Equal(t, Version, log.Version)
+	require.Len(t, log.Runs, 1)
+
+	run := log.Runs[0]
+	assert.Equal(t, "scanner", run.Tool.Driver.Name)
+	require.Len(t, run.Tool.Driver.Rules, 2)
+	// 同一个编号的规则取最高的评分
+	assert.Equal(t, "10.0", run.Tool.Driver.Rules[0].Properties["security-severity"])
+	assert.Equal(t, "1.8", run.Tool.Driver.Rules[1].Properties["security-severity"])
+
+	require.Len(t, run.Results, 3)
+	assert.Equal(t, LevelError, run.Results[0].Level)
+	assert.Equal(t, "CVE-2021-44228: CVSS 10.0 (Critical)", run.Results[0].Message.Text)
+	assert.Equal(t, "pom.xml", run.Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, 42, run.Results[0].Locations[0].PhysicalLocation.Region.StartLine)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", run.Results[0].Properties["cvssVector"])
+
+	assert.Equal(t, "log4j-core in a test dependency", run.Results[1].Message.Text)
+	assert.Equal(t, "9.4", run.Results[1].Properties["security-severity"])
+	assert.Empty(t, run.Results[1].Locations)
+
+	assert.Equal(t, LevelNote, run.Results[2].Level)
+	assert.Equal(t, 1, run.Results[2].RuleIndex)
+}
+
+// TestWriter_Errors 测试不合法的发现
+func TestWriter_Errors(t *testing.T) {
+	writer := NewWriter(&bytes.Buffer{})
+	_, err := writer.Log([]*Finding{{Cvss3x: mustParse(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")}})
+	assert.ErrorIs(t, err, ErrFindingMissingID)
+
+	_, err = writer.Log([]*Finding{nil})
+	assert.ErrorIs(t, err, ErrNilFinding)
+
+	_, err = writer.Log([]*Finding{{ID: "CVE-2020-0001", Cvss3x: mustParse(t, "CVSS:3.1/AV:N")}})
+	assert.ErrorIs(t, err, cvss.ErrMetricMissing)
+}
+
+// TestLevelOf 测试严重性等级到结果级别的映射
+func TestLevelOf(t *testing.T) {
+	assert.Equal(t, LevelError, LevelOf(cvss.SeverityCritical))
+	assert.Equal(t, LevelError, LevelOf(cvss.SeverityHigh))
+	assert.Equal(t, LevelWarning, LevelOf(cvss.SeverityMedium))
+	assert.Equal(t, LevelNote, LevelOf(cvss.SeverityLow))
+	assert.Equal(t, LevelNone, LevelOf(cvss.SeverityNone))
+}
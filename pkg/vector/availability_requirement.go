@@ -1,57 +1,57 @@
 package vector
 
 type AvailabilityRequirement struct {
-	*VectorImpl
+	*vectorImpl
 }
 
 var _ Vector = &AvailabilityRequirement{}
 
 var (
 	AvailabilityRequirementNotDefined = &AvailabilityRequirement{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Environmental Metrics",
-			ShortName:   "AR",
-			LongName:    "Availability Requirement",
-			ShortValue:  'X',
-			LongValue:   "Not Defined",
-			Description: `Assigning this value indicates there is insufficient information to choose one of the other values, and has no impact on the overall Environmental Score, i.e., it has the same effect on scoring as assigning Medium.`,
-			Score:       1,
+		vectorImpl: &VectorImpl{
+			groupName:   "Environmental Metrics",
+			shortName:   "AR",
+			longName:    "Availability Requirement",
+			shortValue:  'X',
+			longValue:   "Not Defined",
+			description: `Assigning this value indicates there is insufficient information to choose one of the other values, and has no impact on the overall Environmental Score, i.e., it has the same effect on scoring as assigning Medium.`,
+			score:       1,
 		},
 	}
 
 	AvailabilityRequirementHigh = &AvailabilityRequirement{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Environmental Metrics",
-			ShortName:   "AR",
-			LongName:    "Availability Requirement",
-			ShortValue:  'H',
-			LongValue:   "High",
-			Description: `Loss of [Confidentiality | Integrity | Availability] is likely to have a catastrophic adverse effect on the organization or individuals associated with the organization (e.g., employees, customers).`,
-			Score:       1.5,
+		vectorImpl: &VectorImpl{
+			groupName:   "Environmental Metrics",
+			shortName:   "AR",
+			longName:    "Availability Requirement",
+			shortValue:  'H',
+			longValue:   "High",
+			description: `Loss of [Confidentiality | Integrity | Availability] is likely to have a catastrophic adverse effect on the organization or individuals associated with the organization (e.g., employees, customers).`,
+			score:       1.5,
 		},
 	}
 
 	AvailabilityRequirementMedium = &AvailabilityRequirement{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Environmental Metrics",
-			ShortName:   "AR",
-			LongName:    "Availability Requirement",
-			ShortValue:  'M',
-			LongValue:   "Medium",
-			Description: `Loss of [Confidentiality | Integrity | Availability] is likely to have a serious adverse effect on the organization or individuals associated with the organization (e.g., employees, customers).`,
-			Score:       1,
+		vectorImpl: &VectorImpl{
+			groupName:   "Environmental Metrics",
+			shortName:   "AR",
+			longName:    "Availability Requirement",
+			shortValue:  'M',
+			longValue:   "Medium",
+			description: `Loss of [Confidentiality | Integrity | Availability] is likely to have a serious adverse effect on the organization or individuals associated with the organization (e.g., employees, customers).`,
+			score:       1,
 		},
 	}
 
 	AvailabilityRequirementLow = &AvailabilityRequirement{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Environmental Metrics",
-			ShortName:   "AR",
-			LongName:    "Availability Requirement",
-			ShortValue:  'L',
-			LongValue:   "Low",
-			Description: `Loss of [Confidentiality | Integrity | Availability] is likely to have only a limited adverse effect on the organization or individuals associated with the organization (e.g., employees, customers).`,
-			Score:       0.5,
+		vectorImpl: &VectorImpl{
+			groupName:   "Environmental Metrics",
+			shortName:   "AR",
+			longName:    "Availability Requirement",
+			shortValue:  'L',
+			longValue:   "Low",
+			description: `Loss of [Confidentiality | Integrity | Availability] is likely to have only a limited adverse effect on the organization or individuals associated with the organization (e.g., employees, customers).`,
+			score:       0.5,
 		},
 	}
 )
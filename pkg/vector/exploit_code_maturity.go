@@ -1,69 +1,69 @@
 package vector
 
 type ExploitCodeMaturity struct {
-	*VectorImpl
+	*vectorImpl
 }
 
 var _ Vector = &ExploitCodeMaturity{}
 
 var (
 	ExploitCodeMaturityNotDefined = &ExploitCodeMaturity{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Temporal Metrics",
-			ShortName:   "E",
-			LongName:    "Exploit Code Maturity",
-			ShortValue:  'X',
-			LongValue:   "Not Defined",
-			Description: `Assigning this value indicates there is insufficient information to choose one of the other values, and has no impact on the overall Temporal Score, i.e., it has the same effect on scoring as assigning High.`,
-			Score:       1,
+		vectorImpl: &VectorImpl{
+			groupName:   "Temporal Metrics",
+			shortName:   "E",
+			longName:    "Exploit Code Maturity",
+			shortValue:  'X',
+			longValue:   "Not Defined",
+			description: `Assigning this value indicates there is insufficient information to choose one of the other values, and has no impact on the overall Temporal Score, i.e., it has the same effect on scoring as assigning High.`,
+			score:       1,
 		},
 	}
 
 	ExploitCodeMaturityHigh = &ExploitCodeMaturity{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Temporal Metrics",
-			ShortName:   "E",
-			LongName:    "Exploit Code Maturity",
-			ShortValue:  'H',
-			LongValue:   "High",
-			Description: `Functional autonomous code exists, or no exploit is required (manual trigger) and details are widely available. Exploit code works in every situation, or is actively being delivered via an autonomous agent (such as a worm or virus). Network-connected systems are likely to encounter scanning or exploitation attempts. Exploit development has reached the level of reliable, widely available, easy-to-use automated tools.`,
-			Score:       1,
+		vectorImpl: &VectorImpl{
+			groupName:   "Temporal Metrics",
+			shortName:   "E",
+			longName:    "Exploit Code Maturity",
+			shortValue:  'H',
+			longValue:   "High",
+			description: `Functional autonomous code exists, or no exploit is required (manual trigger) and details are widely available. Exploit code works in every situation, or is actively being delivered via an autonomous agent (such as a worm or virus). Network-connected systems are likely to encounter scanning or exploitation attempts. Exploit development has reached the level of reliable, widely available, easy-to-use automated tools.`,
+			score:       1,
 		},
 	}
 
 	ExploitCodeMaturityFunctional = &ExploitCodeMaturity{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Temporal Metrics",
-			ShortName:   "E",
-			LongName:    "Exploit Code Maturity",
-			ShortValue:  'F',
-			LongValue:   "Functional",
-			Description: `Functional exploit code is available. The code works in most situations where the vulnerability exists.`,
-			Score:       	0.97,
+		vectorImpl: &VectorImpl{
+			groupName:   "Temporal Metrics",
+			shortName:   "E",
+			longName:    "Exploit Code Maturity",
+			shortValue:  'F',
+			longValue:   "Functional",
+			description: `Functional exploit code is available. The code works in most situations where the vulnerability exists.`,
+			score:       	0.97,
 		},
 	}
 
 	ExploitCodeMaturityProofOfConcept = &ExploitCodeMaturity{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Temporal Metrics",
-			ShortName:   "E",
-			LongName:    "Exploit Code Maturity",
-			ShortValue:  'P',
-			LongValue:   "Proof-of-Concept",
-			Description: `Proof-of-concept exploit code is available, or an attack demonstration is not practical for most systems. The code or technique is not functional in all situations and may require substantial modification by a skilled attacker.`,
-			Score:       0.94,
+		vectorImpl: &VectorImpl{
+			groupName:   "Temporal Metrics",
+			shortName:   "E",
+			longName:    "Exploit Code Maturity",
+			shortValue:  'P',
+			longValue:   "Proof-of-Concept",
+			description: `Proof-of-concept exploit code is available, or an attack demonstration is not practical for most systems. The code or technique is not functional in all situations and may require substantial modification by a skilled attacker.`,
+			score:       0.94,
 		},
 	}
 
 	ExploitCodeMaturityUnproven = &ExploitCodeMaturity{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Temporal Metrics",
-			ShortName:   "E",
-			LongName:    "Exploit Code Maturity",
-			ShortValue:  'U',
-			LongValue:   "Unproven",
-			Description: `No exploit code is available, or an exploit is theoretical.`,
-			Score:       0.91,
+		vectorImpl: &VectorImpl{
+			groupName:   "Temporal Metrics",
+			shortName:   "E",
+			longName:    "Exploit Code Maturity",
+			shortValue:  'U',
+			longValue:   "Unproven",
+			description: `No exploit code is available, or an exploit is theoretical.`,
+			score:       0.91,
 		},
 	}
 )
@@ -0,0 +1,103 @@
+package vector
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrIncomparable 两个向量不能比较严重程度，比如属于不同的指标，或者是依赖基础指标的 Modified Not Defined
+var ErrIncomparable = errors.New("vector compare error, incomparable vectors")
+
+// severityRanks 每个指标的取值按照严重程度从低到高的排名，不能直接使用权重，
+// 因为 Scope 的权重都是0，而 PR 的权重越高表示需要的权限越少。
+// 时间指标和环境需求指标的 Not Defined 对评分的影响和权重为1的取值相同，所以排名也相同
+var severityRanks = map[string]map[rune]int{
+	"AV": {'P': 0, 'L': 1, 'A': 2, 'N': 3},
+	"AC": {'H': 0, 'L': 1},
+	"PR": {'H': 0, 'L': 1, 'N': 2},
+	"UI": {'R': 0, 'N': 1},
+	"S":  {'U': 0, 'C': 1},
+	"C":  {'N': 0, 'L': 1, 'H': 2},
+	"I":  {'N': 0, 'L': 1, 'H': 2},
+	"A":  {'N': 0, 'L': 1, 'H': 2},
+	"E":  {'U': 0, 'P': 1, 'F': 2, 'H': 3, 'X': 3},
+	"RL": {'O': 0, 'T': 1, 'W': 2, 'U': 3, 'X': 3},
+	"RC": {'U': 0, 'R': 1, 'C': 2, 'X': 2},
+	"CR": {'L': 0, 'M': 1, 'H': 2, 'X': 1},
+	"IR": {'L': 0, 'M': 1, 'H': 2, 'X': 1},
+	"AR": {'L': 0, 'M': 1, 'H': 2, 'X': 1},
+}
+
+// modifiedMetrics 修改后的指标简称到基础指标简称的映射，修改后的指标和基础指标使用相同的排名
+var modifiedMetrics = map[string]string{
+	"MAV": "AV",
+	"MAC": "AC",
+	"MPR": "PR",
+	"MUI": "UI",
+	"MS":  "S",
+	"MC":  "C",
+	"MI":  "I",
+	"MA":  "A",
+}
+
+// rankedMetricName 返回用于查找排名的指标简称，修改后的指标使用基础指标的简称
+func rankedMetricName(v Vector) string {
+	if name, ok := modifiedMetrics[v.GetShortName()]; ok {
+		return name
+	}
+	return v.GetShortName()
+}
+
+// SeverityRank 返回向量在所属指标中的严重程度排名，从0开始，越大越严重。
+// nil、未知的指标或取值，以及 Modified Not Defined (X) 这种取决于基础指标的取值没有排名，返回false
+func SeverityRank(v Vector) (int, bool) {
+	if IsNil(v) {
+		return 0, false
+	}
+	rank, ok := severityRanks[rankedMetricName(v)][v.GetShortValue()]
+	return rank, ok
+}
+
+// Compare 比较同一个指标的两个取值的严重程度，a没有b严重时返回-1，a比b严重时返回1，相同时返回0。
+// 修改后的指标可以和对应的基础指标比较，比如 MAV:L 和 AV:N ，其它情况下属于不同指标的向量返回 ErrIncomparable
+func Compare(a, b Vector) (int, error) {
+	rankA, okA := SeverityRank(a)
+	rankB, okB := SeverityRank(b)
+	if !okA || !okB {
+		return 0, fmt.Errorf("%w: %s and %s", ErrIncomparable, vectorString(a), vectorString(b))
+	}
+	if rankedMetricName(a) != rankedMetricName(b) {
+		return 0, fmt.Errorf("%w: %s and %s belong to different metrics", ErrIncomparable, a, b)
+	}
+	switch {
+	case rankA < rankB:
+		return -1, nil
+	case rankA > rankB:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Less a是否没有b严重，不能比较时返回false
+func Less(a, b Vector) bool {
+	c, err := Compare(a, b)
+	return err == nil && c < 0
+}
+
+func vectorString(v Vector) string {
+	if IsNil(v) {
+		return "<nil>"
+	}
+	return v.String()
+}
+
+// IsNil 判断向量是否为空，包括接口中存放了一个nil指针的情况，比如 (*Scope)(nil)
+func IsNil(v Vector) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
@@ -0,0 +1,54 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCompare 测试按照严重程度比较同一个指标的取值
+func TestCompare(t *testing.T) {
+	testCases := []struct {
+		a, b Vector
+		want int
+	}{
+		{AttackVectorNetwork, AttackVectorAdjacent, 1},
+		{AttackVectorPhysical, AttackVectorLocal, -1},
+		// PR 的权重越高需要的权限越少，也就越严重
+		{PrivilegesRequiredHigh, PrivilegesRequiredLow, -1},
+		{PrivilegesRequiredNone, PrivilegesRequiredLow, 1},
+		// Scope 的权重都是0
+		{ScopeChanged, ScopeUnchanged, 1},
+		{ExploitCodeMaturityNotDefined, ExploitCodeMaturityHigh, 0},
+		// 修改后的指标可以和基础指标比较
+		{ModifiedAttackVectorLocal, AttackVectorNetwork, -1},
+		{ModifiedPrivilegesRequiredNone, PrivilegesRequiredNone, 0},
+	}
+	for _, tc := range testCases {
+		c, err := Compare(tc.a, tc.b)
+		assert.NoError(t, err, "%s %s", tc.a, tc.b)
+		assert.Equal(t, tc.want, c, "%s %s", tc.a, tc.b)
+		assert.Equal(t, tc.want < 0, Less(tc.a, tc.b), "%s %s", tc.a, tc.b)
+	}
+}
+
+// TestCompare_Incomparable 测试不能比较的情况
+func TestCompare_Incomparable(t *testing.T) {
+	_, err := Compare(AttackVectorNetwork, ScopeChanged)
+	assert.ErrorIs(t, err, ErrIncomparable)
+
+	_, err = Compare(ModifiedAttackVectorNotDefined, AttackVectorNetwork)
+	assert.ErrorIs(t, err, ErrIncomparable)
+
+	_, err = Compare(nil, AttackVectorNetwork)
+	assert.ErrorIs(t, err, ErrIncomparable)
+
+	// 接口中存放了nil指针
+	_, err = Compare(AttackVectorNetwork, (*AttackVector)(nil))
+	assert.ErrorIs(t, err, ErrIncomparable)
+	_, ok := SeverityRank((*Scope)(nil))
+	assert.False(t, ok)
+	assert.False(t, Less((*Scope)(nil), ScopeChanged))
+
+	assert.False(t, Less(ModifiedAttackVectorNotDefined, AttackVectorNetwork))
+}
@@ -1,59 +1,71 @@
 package vector
 
 type UserInteraction struct {
-	*VectorImpl
+	*vectorImpl
 }
 
 var _ Vector = &UserInteraction{}
 
 var (
 	UserInteractionNone = &UserInteraction{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Base Metrics",
-			ShortName:   "UI",
-			LongName:    "User Interaction",
-			ShortValue:  'N',
-			LongValue:   "None",
-			Description: `The vulnerable system can be exploited without interaction from any user.`,
-			Score:       0.85,
+		vectorImpl: &VectorImpl{
+			groupName:   "Base Metrics",
+			shortName:   "UI",
+			longName:    "User Interaction",
+			shortValue:  'N',
+			longValue:   "None",
+			description: `The vulnerable system can be exploited without interaction from any user.`,
+			score:       0.85,
 		},
 	}
 
 	UserInteractionRequired = &UserInteraction{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Base Metrics",
-			ShortName:   "UI",
-			LongName:    "User Interaction",
-			ShortValue:  'R',
-			LongValue:   "Required",
-			Description: `Successful exploitation of this vulnerability requires a user to take some action before the vulnerability can be exploited. For example, a successful exploit may only be possible during the installation of an application by a system administrator.`,
-			Score:       0.62,
+		vectorImpl: &VectorImpl{
+			groupName:   "Base Metrics",
+			shortName:   "UI",
+			longName:    "User Interaction",
+			shortValue:  'R',
+			longValue:   "Required",
+			description: `Successful exploitation of this vulnerability requires a user to take some action before the vulnerability can be exploited. For example, a successful exploit may only be possible during the installation of an application by a system administrator.`,
+			score:       0.62,
 		},
 	}
 )
 
 var (
 	ModifiedUserInteractionNone = &UserInteraction{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Environmental",
-			ShortName:   "MUI",
-			LongName:    "Modified User Interaction",
-			ShortValue:  'N',
-			LongValue:   "None",
-			Description: `The vulnerable system can be exploited without interaction from any user.`,
-			Score:       0.85,
+		vectorImpl: &VectorImpl{
+			groupName:   "Environmental",
+			shortName:   "MUI",
+			longName:    "Modified User Interaction",
+			shortValue:  'N',
+			longValue:   "None",
+			description: `The vulnerable system can be exploited without interaction from any user.`,
+			score:       0.85,
 		},
 	}
 
 	ModifiedUserInteractionRequired = &UserInteraction{
-		VectorImpl: &VectorImpl{
-			GroupName:   "Environmental",
-			ShortName:   "MUI",
-			LongName:    "Modified User Interaction",
-			ShortValue:  'R',
-			LongValue:   "Required",
-			Description: `Successful exploitation of this vulnerability requires a user to take some action before the vulnerability can be exploited. For example, a successful exploit may only be possible during the installation of an application by a system administrator.`,
-			Score:       0.62,
+		vectorImpl: &VectorImpl{
+			groupName:   "Environmental",
+			shortName:   "MUI",
+			longName:    "Modified User Interaction",
+			shortValue:  'R',
+			longValue:   "Required",
+			description: `Successful exploitation of this vulnerability requires a user to take some action before the vulnerability can be exploited. For example, a successful exploit may only be possible during the installation of an application by a system administrator.`,
+			score:       0.62,
+		},
+	}
+
+	ModifiedUserInteractionNotDefined = &UserInteraction{
+		vectorImpl: &VectorImpl{
+			groupName:   "Environmental",
+			shortName:   "MUI",
+			longName:    "Modified User Interaction",
+			shortValue:  'X',
+			longValue:   "Not Defined",
+			description: `The value assigned to the corresponding Base metric is used.`,
+			score:       0,
 		},
 	}
 )
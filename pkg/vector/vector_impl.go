@@ -2,46 +2,96 @@ package vector
 
 import "fmt"
 
+// VectorImpl 是 Vector 接口的通用实现，所有字段都是不可导出的，只能通过 NewVectorImpl 创建，
+// 创建之后不能再通过字段或者方法修改，所以包中导出的向量单例可以放心地在整个进程中共享。
+// 但是 Go 无法阻止下面两种整体替换，它们会影响进程中所有使用这个单例的地方，请不要这样做：
+//
+//	*vector.AttackVectorNetwork = *vector.AttackVectorPhysical // 替换指针指向的结构体
+//	vector.AttackVectorNetwork = vector.AttackVectorPhysical   // 给导出的变量重新赋值
+//
+// 零值的指标类型，比如 &vector.Scope{} ，嵌入的 VectorImpl 为nil，各个方法返回零值，
+// 校验时会被当作不合法的向量而不会panic
 type VectorImpl struct {
-	GroupName   string
-	ShortName   string
-	LongName    string
-	ShortValue  rune
-	LongValue   string
-	Description string
-	Score       float64
+	groupName   string
+	shortName   string
+	longName    string
+	shortValue  rune
+	longValue   string
+	description string
+	score       float64
 }
 
+// vectorImpl 各个指标类型通过这个别名嵌入 VectorImpl ，这样嵌入的字段是不可导出的，
+// 包外无法把单例中的 VectorImpl 替换掉，但是 VectorImpl 的方法仍然会被提升
+type vectorImpl = VectorImpl
+
 var _ Vector = &VectorImpl{}
 
+// NewVectorImpl 创建一个向量，用于在包外实现自定义的 Vector
+func NewVectorImpl(groupName, shortName, longName string, shortValue rune, longValue, description string, score float64) *VectorImpl {
+	return &VectorImpl{
+		groupName:   groupName,
+		shortName:   shortName,
+		longName:    longName,
+		shortValue:  shortValue,
+		longValue:   longValue,
+		description: description,
+		score:       score,
+	}
+}
+
 func (x *VectorImpl) GetGroupName() string {
-	return x.GroupName
+	if x == nil {
+		return ""
+	}
+	return x.groupName
 }
 
 func (x *VectorImpl) GetShortName() string {
-	return x.ShortName
+	if x == nil {
+		return ""
+	}
+	return x.shortName
 }
 
 func (x *VectorImpl) GetLongName() string {
-	return x.LongName
+	if x == nil {
+		return ""
+	}
+	return x.longName
 }
 
 func (x *VectorImpl) GetShortValue() rune {
-	return x.ShortValue
+	if x == nil {
+		return 0
+	}
+	return x.shortValue
 }
 
 func (x *VectorImpl) GetLongValue() string {
-	return x.LongValue
+	if x == nil {
+		return ""
+	}
+	return x.longValue
 }
 
 func (x *VectorImpl) GetDescription() string {
-	return x.Description
+	if x == nil {
+		return ""
+	}
+	return x.description
 }
 
 func (x *VectorImpl) GetScore() float64 {
-	return x.Score
+	if x == nil {
+		return 0
+	}
+	return x.score
 }
 
 func (x *VectorImpl) String() string {
-	return fmt.Sprintf("%s:%c", x.ShortName, x.ShortValue)
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s:%c", x.shortName, x.shortValue)
 }
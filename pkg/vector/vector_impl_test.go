@@ -0,0 +1,49 @@
+package vector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestVectorImpl_Immutable 测试导出的向量单例在包外无法被修改，也就是没有任何可导出的字段
+func TestVectorImpl_Immutable(t *testing.T) {
+	for _, v := range []Vector{
+		AttackVectorNetwork, AttackComplexityLow, PrivilegesRequiredNone, UserInteractionNone,
+		ScopeUnchanged, ConfidentialityHigh, IntegrityHigh, AvailabilityHigh,
+		ExploitCodeMaturityHigh, RemediationLevelOfficialFix, ReportConfidenceConfirmed,
+		ConfidentialityRequirementHigh, IntegrityRequirementHigh, AvailabilityRequirementHigh,
+		&VectorImpl{},
+	} {
+		typ := reflect.TypeOf(v).Elem()
+		for i := 0; i < typ.NumField(); i++ {
+			assert.False(t, typ.Field(i).IsExported(), "%s.%s", typ.Name(), typ.Field(i).Name)
+		}
+	}
+}
+
+// TestNewVectorImpl 测试在包外创建自定义向量
+func TestNewVectorImpl(t *testing.T) {
+	v := NewVectorImpl("Custom Metrics", "X", "Custom", 'Y', "Yes", "custom metric", 0.5)
+	assert.Equal(t, "Custom Metrics", v.GetGroupName())
+	assert.Equal(t, "X", v.GetShortName())
+	assert.Equal(t, "Custom", v.GetLongName())
+	assert.Equal(t, 'Y', v.GetShortValue())
+	assert.Equal(t, "Yes", v.GetLongValue())
+	assert.Equal(t, "custom metric", v.GetDescription())
+	assert.Equal(t, 0.5, v.GetScore())
+	assert.Equal(t, "X:Y", v.String())
+
+	assert.Equal(t, "AV:N", AttackVectorNetwork.String())
+	assert.Equal(t, 0.85, AttackVectorNetwork.GetScore())
+}
+
+// TestVectorImpl_ZeroValue 测试零值的指标类型不会panic
+func TestVectorImpl_ZeroValue(t *testing.T) {
+	v := &Scope{}
+	assert.Equal(t, "", v.GetShortName())
+	assert.Equal(t, rune(0), v.GetShortValue())
+	assert.Equal(t, 0.0, v.GetScore())
+	assert.Equal(t, "<nil>", v.String())
+}